@@ -0,0 +1,19 @@
+@main => {
+	greet = (name, greeting = "Hello", punctuation = "!") -> "${greeting}, ${name}${punctuation}"
+	std:println(greet("Ada"))
+	std:println(greet("Ada", "Hi"))
+	std:println(greet("Ada", punctuation: "?"))
+	std:println(greet(greeting: "Howdy", name: "Tim"))
+
+	# Defaults can refer to earlier parameters
+	area = (width, height = width) -> width * height
+	std:println(area(3), " ", area(3, 4))
+
+	sum = (first, ...rest) -> list:reduce(rest, first, (total, x) -> total + x)
+	std:println(sum(1), " ", sum(1, 2, 3, 4))
+
+	tagged = (tag, [x, y], ...more) -> "${tag}: ${x}, ${y} and ${std:len(more)} more"
+	std:println(tagged("point", [1, 2], "a", "b"))
+
+	std:println(math:max(3, 9, 4), " ", std:sprintf("%d-%d", 1, 2))
+}
@@ -0,0 +1,19 @@
+@main => {
+	header = b"\x89PNG\r\n\x1a\n"
+	std:println(header, " is ", std:len(header), " bytes, starting with ", bytes:get(header, 0))
+	std:println(bytes:slice(header, 1, 4), " == ", bytes:from_string("PNG"), ": ", bytes:slice(header, 1, 4) == b"PNG")
+
+	f = std:open("/tmp/rift_bytes.bin", "wb")
+	std:write(f, bytes:concat(header, bytes:from_list([0, 255, 128])))
+	std:close(f)
+
+	f = std:open("/tmp/rift_bytes.bin", "rb")
+	contents = std:read_all(f)
+	std:close(f)
+	std:println("Read back: ", contents, " ", bytes:to_list(bytes:slice(contents, 8)))
+
+	cafe = bytes:from_string("café")
+	std:println(cafe, " as latin-1: ", bytes:from_string("café", "latin-1"))
+	std:println(bytes:to_string(cafe), " ", try { bytes:to_string(bytes:from_list([255]), "utf-8") } catch e { e })
+	std:println(hex:encode(crypto:sha256(header)))
+}
@@ -22,4 +22,24 @@
 	}
 
 	std:println(msg)
-}
\ No newline at end of file
+
+	grade = (score) -> if score >= 90 {
+		"A"
+	} else if score >= 80 {
+		"B"
+	} else if score >= 70 {
+		"C"
+	} else {
+		"F"
+	}
+	std:println(grade(95), grade(85), grade(72), grade(10))
+
+	# An if whose condition doesn't hold, and has no else, gives back nil
+	nothing = if false {
+		"never"
+	}
+	std:println(nothing)
+
+	std:println("Balance is ", if 0 > 5 { "negative" } else if 0 == 5 { "zero" } else { "positive" })
+	std:println(1 + if true { 1 } else { 0 })
+}
@@ -0,0 +1,15 @@
+@main => {
+	payload = "{\"amount\": 100}"
+	secret = "s3cr3t"
+
+	std:println("sha256: ", hex:encode(crypto:sha256(payload)))
+	std:println("md5:    ", hex:encode(crypto:md5(payload)))
+
+	signature = base64:encode(crypto:hmac("sha256", secret, payload))
+	std:println("Signature: ", signature)
+	std:println("Verified: ", crypto:equal(base64:decode(signature), crypto:hmac("sha256", secret, payload)))
+
+	binary = hex:decode("ff00fe")
+	std:println("Round trip: ", hex:encode(base64:decode(base64:encode(binary, "url"), "url")))
+	std:println(uuid:v4(), " ", try { hex:decode("zz") } catch e { e })
+}
@@ -0,0 +1,25 @@
+@main => {
+	divmod = (a, b) -> (a / b, a % b)
+	(q, r) = divmod(17, 5)
+	std:println("17 = 5 * ${q} + ${r}")
+
+	xs = [1, 2, 3, 4]
+	[head, ...tail] = xs
+	std:println("head ${head}, tail ${tail}")
+
+	person = {"name": "Ada", "age": 36, "born": "London"}
+	{name, age} = person
+	std:println("${name} is ${age}")
+
+	{"born": city, "langs": [first, ..._]} = {"born": "Paris", "langs": ["go", "c"]}
+	std:println("born in ${city}, first wrote ${first}")
+
+	swap = ((a, b)) -> (b, a)
+	std:println(swap((1, 2)))
+
+	greet = ({name}, greeting) -> "${greeting}, ${name}!"
+	std:println(greet(person, "Hello"))
+
+	first_two = ([a, b, ..._]) -> a + b
+	std:println(first_two(xs))
+}
@@ -0,0 +1,63 @@
+# Outside of main too, the caught error is bound by its bare name
+safely => {
+	parsed = try { json:parse("{") } catch e { error:kind(e) }
+}
+
+@main => {
+	check_age = (age) -> {
+		if age < 0 {
+			std:raise(std:error("invalid_age", "Age can't be negative", {"age": age}))
+		}
+		age
+	}
+
+	load = (age) -> check_age(age)
+
+	result = try {
+		load(-3)
+	} catch e {
+		std:println("Caught ", e, " with data ", error:data(e))
+		std:println("Raised through ", error:stack(e))
+		0
+	} finally {
+		std:println("Finally runs either way")
+	}
+	std:println("Result: ", result)
+
+	std:println(try { load(40) } catch e { -1 })
+
+	# Runtime failures can be caught too
+	std:println(try { 1 + "one" } catch e { error:kind(e) })
+	std:println(try { std:raise("just a message") } catch e { error:message(e) })
+	std:println(safely:parsed)
+
+	# Errors can be raised again from a catch
+	try {
+		try {
+			std:open("/no/such/file", "r")
+		} catch e {
+			std:raise(e)
+		} finally {
+			std:println("Inner finally")
+		}
+	} catch e {
+		std:println("Outer caught ", error:kind(e))
+	}
+
+	for i in 0..5 {
+		try {
+			if i == 2 {
+				continue
+			}
+			if i == 4 {
+				break
+			}
+			std:println("Loop ", i)
+		} finally {
+			std:println("Leaving ", i)
+		}
+	}
+
+	# Left uncaught, load(-1) would print the error with its stack, ending in
+	# "at rift main", and exit with status 3
+}
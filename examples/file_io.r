@@ -1,9 +1,35 @@
 @main => {
-	f = std:open("/tmp/test.txt")
+	f = std:open("/tmp/test.txt", "w")
 
 	std:write(f, "Line 1\n")
 	std:write(f, "Line 2\n")
 	std:write(f, "Line 3\n")
 
 	std:close(f)
-}
\ No newline at end of file
+
+	f = std:open("/tmp/test.txt", "r")
+	std:println("First line: ", std:read_line(f))
+	std:println("The rest: ", std:lines(f))
+	std:close(f)
+
+	std:println(std:stat("/tmp/test.txt"))
+
+	try {
+		std:open("/tmp/no/such/file.txt", "r")
+	} catch e {
+		std:println("Couldn't open: ", error:message(e), " (", error:kind(e), ")")
+	}
+
+	# Or as a result, to check rather than catch, with ? giving back an err
+	# from the function as soon as there is one
+	first_line = (path) -> {
+		f = std:result(std:open, path, "r")?
+		line = std:read_line(f)
+		std:close(f)
+		std:ok(line)
+	}
+	std:println(first_line("/tmp/test.txt"))
+	missing = first_line("/tmp/no/such/file.txt")
+	std:println("Opened: ", std:is_ok(missing))
+	std:println(std:unwrap_or(missing, "(nothing)"))
+}
@@ -0,0 +1,12 @@
+# Needs network access, so failures are caught and printed instead
+@main => {
+	try {
+		res = http:request({"url": "https://httpbin.org/get", "headers": {"X-Greeting": "hello"}, "timeout": 2})
+		std:println("Status ", map:get(res, "status"), ", content type ", map:get(map:get(res, "headers"), "Content-Type"))
+
+		echoed = http:post_json("https://httpbin.org/post", {"name": "rift"})
+		std:println("Echoed ", map:get(echoed, "json"))
+	} catch e {
+		std:println("Request failed: ", e)
+	}
+}
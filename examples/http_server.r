@@ -0,0 +1,28 @@
+# Run with `rift examples/http_server.r` and stop with Ctrl-C
+@main => {
+	hello = (req) -> "Hello from Rift!"
+
+	user = (req) -> {
+		id = map:get(map:get(req, "params"), "id")
+		{"id": id, "name": str:upper(id)}
+	}
+
+	echo = (req) -> {
+		{"status": 201, "headers": {"X-Echoed": "yes"}, "body": map:get(req, "body")}
+	}
+
+	logged = (req, next) -> {
+		started = time:now()
+		res = next(req)
+		std:eprintln(map:get(req, "method"), " ", map:get(req, "path"), " took ", time:since(started))
+		res
+	}
+
+	routes = http:router([
+		["GET", "/", hello],
+		["GET", "/users/:id", user],
+		["POST", "/echo", echo]
+	])
+
+	http:serve("127.0.0.1:8830", http:use(routes, [logged]))
+}
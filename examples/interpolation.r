@@ -0,0 +1,11 @@
+@main => {
+	a = 10
+	b = 20
+	std:println("${a} + ${b} = ${a + b}")
+
+	price = 3.14159
+	name = "rift"
+	std:println("[${name:-8s}] costs ${price:.2f}, or ${price * 100:08.3f} in cents")
+	std:println("${255:x} in hex, ${str:upper(name)} shouting, ${[1, 2] } listed")
+	std:println("Literal \${not interpolated} and a lone $ sign")
+}
@@ -0,0 +1,10 @@
+@main => {
+	config = json:parse("{\"name\": \"rift\", \"version\": 1.5, \"tags\": [\"lang\", \"vm\"], \"debug\": false, \"owner\": null}")
+	std:println(config)
+	std:println(map:get(config, "tags"))
+
+	std:println(json:stringify({"b": [1, 2, "three"], "a": true, "c": {"nested": "<ok>"}}))
+	std:println(json:stringify(config, 2))
+
+	std:println(try { json:parse("{\"name\": \"rift\",\n \"broken\": }") } catch e { e })
+}
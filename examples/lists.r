@@ -0,0 +1,20 @@
+@main => {
+	numbers = list:range(1, 11)
+	squares = list:map(numbers, (n) -> n * n)
+	evens = list:filter(squares, (n) -> 0 == n % 2)
+	total = list:reduce(evens, 0, (sum, n) -> sum + n)
+
+	std:println("Squares: ", squares)
+	std:println("Even squares: ", evens, " sum to ", total)
+
+	list:each(list:take(numbers, 3), (n) -> std:println("HAI [", n, "]"))
+
+	names = ["carol", "alice", "bob"]
+	std:println(list:sort(names), " ", list:reverse(names))
+	std:println(list:sort_by(numbers, (a, b) -> a > b))
+	std:println(list:zip(names, numbers))
+	std:println(list:flatten([[1, 2], [3], 4]))
+	std:println(list:any(numbers, (n) -> n > 9), " ", list:all(numbers, (n) -> n > 9))
+	std:println(list:find(names, (name) -> std:len(name) == 3))
+	std:println(list:group_by(numbers, (n) -> n % 3))
+}
@@ -1,30 +1,45 @@
-@main => {	
-	for = (s, e, f) -> {
-		_for = (accum, next) -> {
-			if accum < e {
-				f(accum)
-				next(accum + 1, next)
-			}
-		}
-		_for(s, _for)
+# Outside of main, assigned names are kept to their rift, but loop variables
+# are bound by their bare name, like parameters
+tally => {
+	total = 0
+	for n in 1..4 {
+		total = tally:total + n
 	}
-	
-	# for(0, 100, (i) -> std:println("HAI [", i, "]"))
+}
 
-	while = (c, f) -> {
-		_while = (next) -> {
-			if c() {
-				f()
-				next(next)
-			}
-		}
-		_while(_while)
+@main => {
+	for i in 0..3 {
+		std:println("HAI [", i, "]")
+	}
+
+	for word in ["alpha", "beta", "gamma"] {
+		std:println(word)
+	}
+
+	for [key, value] in {"a": 1, "b": 2} {
+		std:println(key, " = ", value)
 	}
-	
 
 	i = 10
-	while(() -> {
-		i = i - 1 
-		i > 0
-	}, () -> std:println("HAI [", i, "]"))
-}
\ No newline at end of file
+	while i > 0 {
+		i = i - 1
+		if 0 == i % 2 {
+			continue
+		}
+		if i < 4 {
+			break
+		}
+		std:println("odd [", i, "]")
+	}
+
+	# Ranges are values too, and only built as lists when used as one
+	r = 1..5
+	std:println(r, " has ", std:len(r), " values: ", list:map(r, (x) -> x * x))
+
+	total = 0
+	for n in 0..100000 {
+		total = total + n
+	}
+	std:println(total)
+	std:println(tally:total)
+}
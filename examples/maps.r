@@ -0,0 +1,14 @@
+@main => {
+	ages = {"alice": 31, "bob": 27}
+	older = map:put(ages, "carol", 45)
+
+	std:println(ages, " -> ", older)
+	std:println("dave is ", map:get(older, "dave", "unknown"))
+	std:println(map:keys(older), " ", map:values(older))
+	std:println(map:has(older, "bob"), " ", map:delete(older, "bob"))
+	std:println(map:merge(ages, {"bob": 28, "erin": 19}))
+	std:println(map:map_values(ages, (age) -> age + 1))
+	std:println(map:filter(older, (name, age) -> age > 30))
+
+	list:each(older, (entry) -> std:println(entry))
+}
@@ -0,0 +1,49 @@
+@main => {
+	describe = (x) -> match x {
+		0 -> "zero",
+		1 -> "one",
+		"hi" -> "a greeting",
+		true -> "yes",
+		[] -> "an empty list",
+		[only] -> "a list of just ${only}",
+		[first, 2, ...rest] -> "a list starting ${first}, 2 and then ${rest}",
+		[first, ...rest] -> "a list starting ${first}",
+		(x, y) -> "a pair",
+		{"kind": "circle", "radius": r} -> "a circle of radius ${r}",
+		{name, age} if age >= 18 -> "${name}, an adult",
+		{name} -> "${name}, a minor or ageless",
+		n if n < 0 -> "negative",
+		_ -> "something else"
+	}
+
+	std:println(describe(0))
+	std:println(describe(1))
+	std:println(describe("hi"))
+	std:println(describe(true))
+	std:println(describe([]))
+	std:println(describe([7]))
+	std:println(describe([1, 2, 3, 4]))
+	std:println(describe([1, 3]))
+	std:println(describe({"kind": "circle", "radius": 2.5}))
+	std:println(describe({"name": "Ada", "age": 36}))
+	std:println(describe({"name": "Tim", "age": 9}))
+	std:println(describe({"name": "Nobody"}))
+	std:println(describe(-4))
+	std:println(describe(42))
+
+	fizzbuzz = (n) -> match [n % 3, n % 5] {
+		[0, 0] -> "FizzBuzz",
+		[0, _] -> "Fizz",
+		[_, 0] -> "Buzz",
+		_ -> n
+	}
+	std:println(fizzbuzz(3), " ", fizzbuzz(10), " ", fizzbuzz(15), " ", fizzbuzz(7))
+
+	sign = (n) -> match 0 < n {
+		true -> {
+			"positive"
+		},
+		false -> "not positive"
+	}
+	std:println(sign(3), " ", sign(-3))
+}
@@ -7,4 +7,16 @@
 
 	d = c ** 2
 	std:println(c, " ** 2 = ", d)
-}
\ No newline at end of file
+
+	r = 2.5
+	area = math:pi * r ** 2
+	std:println("Area of a circle with radius ", r, " = ", math:round(area))
+	std:println("sqrt(2) = ", math:sqrt(2), ", 7 / 2 = ", 7 / 2, ", 7.0 / 2 = ", 7.0 / 2)
+	std:println("div(7, 2) = ", math:div(7, 2), ", idiv(-7, 2) = ", math:idiv(-7, 2))
+	std:println("max(3, 9.5, 4) = ", math:max(3, 9.5, 4), ", clamp(15, 0, 10) = ", math:clamp(15, 0, 10))
+	std:println("is_nan(sqrt(-1)) = ", math:is_nan(math:sqrt(-1)))
+
+	rand:seed(42)
+	std:println("Dice roll: ", rand:int(1, 7), ", coin: ", rand:choice(["heads", "tails"]))
+	std:println("Shuffled: ", rand:shuffle(list:range(0, 5)), ", secure: ", rand:secure_int(100))
+}
@@ -0,0 +1,33 @@
+# Names can hold digits after the first character, and end in ? or !
+@main => {
+	utf8 = "bytes"
+	x1 = 1
+	x2 = x1 + 1
+	std:println(utf8, " ", x1, " ", x2)
+
+	# Used without calling it, a name ending in ? would propagate instead
+	empty? = (xs) -> 0 == std:len(xs)
+	std:println(empty?([]), " ", empty?([1]))
+
+	counter = 0
+	bump! = () -> {
+		counter = counter + 1
+		counter
+	}
+	bump!()
+	std:println(bump!())
+
+	# Keywords are only reserved as whole words
+	iffy = 1
+	truest = true
+	falsehood = false
+	elsewhere = "here"
+	std:println(iffy, " ", truest, " ", falsehood, " ", elsewhere)
+	if truest {
+		std:println("if still works")
+	} else {
+		std:println("else still works")
+	}
+
+	std:println(str:upper(elsewhere), " ", hex:encode(crypto:sha1(utf8)))
+}
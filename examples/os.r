@@ -0,0 +1,20 @@
+@main => {
+	std:println("Arguments: ", os:args())
+	std:println("Running on ", os:hostname(), " in ", os:cwd(), " with HOME=", os:env("HOME"))
+
+	os:set_env("GREETING", "hello")
+	try {
+		result = os:exec("sh", ["-c", "echo $GREETING $EXTRA; echo oops >&2; exit 3"], {"env": {"EXTRA": "world"}})
+		std:println("Exit code ", map:get(result, "code"), ", stdout: ", str:trim(map:get(result, "stdout")), ", stderr: ", str:trim(map:get(result, "stderr")))
+	} catch e {
+		std:println("Couldn't run the command: ", e)
+	}
+
+	try {
+		os:exec("sleep", ["5"], {"timeout": 0.1})
+	} catch e {
+		std:println(e)
+	} finally {
+		std:println("Done with processes")
+	}
+}
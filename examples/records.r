@@ -0,0 +1,31 @@
+@main => {
+	# Record types are named after what they're assigned to
+	point = record(x, y)
+	line = record(start, end, label)
+	std:println(point)
+
+	# Fields are given by position or by name
+	p = point(1, 2)
+	q = point(y: 4, x: 3)
+	std:println(p, " ", q)
+	std:println("x is ${p.x} and y is ${p.y}")
+
+	# Records print and compare by their fields
+	l = line(p, q, "diagonal")
+	std:println(l)
+	std:println(l.end.x, " ", p == point(1, 2), " ", p == q)
+
+	# Updating gives back a copy, leaving the original as it was
+	moved = p with {x: 3}
+	std:println(moved, " ", p)
+	std:println(l with {label: "moved", start: moved})
+
+	# Record types work anywhere a function does
+	std:println(std:result(point, 5, 5), " ", std:result(point, 5))
+
+	try {
+		p.z
+	} catch e {
+		std:println("Caught ", error:kind(e), ": ", error:message(e))
+	}
+}
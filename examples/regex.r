@@ -0,0 +1,14 @@
+@main => {
+	date = re:compile("(?P<year>[0-9]{4})-(?P<month>[0-9]{2})-(?P<day>[0-9]{2})")
+	std:println(date)
+
+	found = re:match(date, "Released on 2015-04-29, patched 2015-05-02")
+	std:println(map:get(found, "text"), " at ", map:get(found, "start"), " with ", map:get(found, "named"))
+
+	all = re:find_all(date, "Released on 2015-04-29, patched 2015-05-02")
+	std:println(list:map(all, (m) -> map:get(m, "groups")))
+
+	std:println(re:replace(date, "Due 2015-04-29", "\${day}/\${month}/$year"))
+	std:println(re:split("[,;] *", "a, b;c ,d"))
+	std:println(re:match("x", "abc"), " ", try { re:compile("(unclosed") } catch e { e })
+}
@@ -0,0 +1,35 @@
+@main => {
+	# Results hold a value, or the reason there isn't one
+	parsed = std:ok(42)
+	failed = std:err("not a number")
+	std:println(parsed, " ", failed)
+	std:println(std:is_ok(parsed), " ", std:is_err(failed))
+	std:println(std:unwrap(parsed), " ", std:unwrap_or(failed, 0))
+
+	# Any builtin can give back a result instead of raising
+	std:println(std:result(std:open, "/tmp/no/such/file.txt", "r"))
+
+	# ? gives back an err from the enclosing function, or unwraps an ok. It can
+	# follow a call or a name.
+	halve = (n) -> if 0 == n % 2 { std:ok(n / 2) } else { std:err("${n} is odd") }
+	quarter = (n) -> {
+		half = halve(n)?
+		halve(half)
+	}
+	std:println(quarter(12), " ", quarter(6), " ", quarter(3))
+	eighth = (n) -> {
+		q = quarter(n)
+		halve(q?)
+	}
+	std:println(eighth(16), " ", eighth(6))
+
+	# Outside of any function, ? raises instead, so a try around it catches it
+	std:println(try { halve(5)? } catch e { error:message(e) })
+
+	# An err from std:unwrap raises its reason
+	try {
+		std:unwrap(quarter(3))
+	} catch e {
+		std:println("Caught ", error:kind(e), ": ", error:message(e))
+	}
+}
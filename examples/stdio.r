@@ -0,0 +1,6 @@
+@main => {
+	std:write(std:stdout, "What's your name? ")
+	name = std:read_line()
+	std:println("Hello, ", name, "!")
+	std:eprintln("(greeted ", name, " on ", std:stderr, ")")
+}
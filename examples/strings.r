@@ -0,0 +1,18 @@
+@main => {
+	greeting = "  Héllo, wörld!  "
+	trimmed = str:trim(greeting)
+
+	std:println("[", trimmed, "] has ", std:len(trimmed), " characters")
+	std:println(str:upper(trimmed))
+	std:println(str:replace(trimmed, "wörld", "Rift"))
+	std:println(str:char_at(trimmed, 1), " is at index ", str:index_of(trimmed, "é"))
+	std:println(str:substring(trimmed, 7, 12))
+
+	words = str:split("a,b,c", ",")
+	std:println(words, " joined: ", str:join(words, " | "))
+	std:println(str:pad_left(str:to_string(42), 6, "0"))
+
+	n = str:parse_int("123")
+	std:println(n + 1)
+	std:println(try { str:parse_int("abc") } catch e { e })
+}
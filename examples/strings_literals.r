@@ -0,0 +1,14 @@
+@main => {
+	std:println("Caf\xe9 costs \u20ac3, \U0001F600")
+	std:println("Tab:\tNull byte is ", std:len("a\0b"), " characters long")
+
+	pattern = `\d{4}-\d{2}`
+	std:println(pattern, " finds ", re:find_all(pattern, "2015-04 and 2016-05"))
+
+	template = """Dear "customer",
+	Your order shipped.\n	-- The Rift team"""
+	std:println(template)
+
+	windows = `C:\Users\rift`
+	std:println(windows)
+}
@@ -0,0 +1,16 @@
+@main => {
+	started = time:now()
+	std:println("Started at ", time:format(started, "datetime"))
+
+	time:sleep(time:milliseconds(50))
+	std:println("Slept for at least 50ms: ", time:since(started) >= time:milliseconds(50))
+
+	launch = time:parse("2015-04-29 09:30:00", "datetime", "America/Los_Angeles")
+	std:println("Launch: ", launch, " (", time:unix(launch), ")")
+	std:println("In Tokyo: ", time:in_zone(launch, "Asia/Tokyo"))
+	std:println("A week later: ", time:format(time:add(launch, time:hours(24 * 7)), "date"))
+
+	meeting = time:add(time:hours(1), time:duration("30m"))
+	std:println("Meeting length: ", meeting, " = ", time:to_seconds(meeting), "s")
+	std:println(time:from_unix(0), " ", try { time:parse("not a date", "date") } catch e { error:kind(e) })
+}
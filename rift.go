@@ -7,6 +7,7 @@ import (
 	"rift/lang"
 	"rift/support/logging"
 	"rift/runtime"
+	"rift/runtime/sys"
 )
 
 const (
@@ -14,6 +15,7 @@ const (
 	INVALID_ARGS = 0
 	INVALID_FILE = 1
 	SYNTAX_ERROR = 2
+	RUNTIME_ERROR = 3
 )
 
 func main() {
@@ -22,6 +24,7 @@ func main() {
 
 	showVersion  := flags.Bool("version", false, "Prints this version of Rift")
 	debug := flags.Bool("verbose", false, "")
+	allowExec := flags.Bool("allow-exec", false, "")
 
 	flags.Parse(os.Args[1:])
 
@@ -29,6 +32,9 @@ func main() {
 		logging.CurrentLevel = logging.DEBUG
 	}
 
+	sys.AllowExec = *allowExec
+
+	// Everything after the script is handed to it as os:args()
 	args := flags.Args()
 
 	switch {
@@ -37,15 +43,17 @@ func main() {
 	case *showVersion:
 		printVersion()
 	case len(args) >= 1:
-		run(args)
+		sys.Args = args[1:]
+		run(args[:1])
 	}
 }
 
 func printUsage() {
-	fmt.Printf("Usage: rift [OPTIONS] [FILES]\n\n" +
+	fmt.Printf("Usage: rift [OPTIONS] FILE [ARGS]\n\n" +
 		"OPTIONS\n" +
 		"  --verbose Prints verbose Rift interpreter logs\n" +
 		"  --version Prints the Rift version\n" +
+		"  --allow-exec Allows the script to run processes with os:exec\n" +
 		"\n")
 	os.Exit(INVALID_ARGS)
 }
@@ -68,6 +76,9 @@ func build(filenames []string) []*lang.Node {
 			fmt.Printf("Syntax error [%s]: %+v\n", filename, parseErr)
 			os.Exit(SYNTAX_ERROR)
 		}
+		for _, warning := range parsed.Warnings() {
+			fmt.Fprintf(os.Stderr, "Warning [%s]: %s\n", filename, warning)
+		}
 
 		rifts = append(rifts, parsed.Rifts()...)
 	}
@@ -77,7 +88,10 @@ func build(filenames []string) []*lang.Node {
 
 func run(filenames []string) {
 	rifts := build(filenames)
-	runtime.Run(rifts)
+	if err := runtime.Run(rifts); err != nil {
+		fmt.Fprintf(os.Stderr, "Uncaught %s\n", err.Trace())
+		os.Exit(RUNTIME_ERROR)
+	}
 	// initialCtx := runtime.BuildContext(rifts)
 	// dispatcher := runtime.LocalDispatcher{}
 	// vm := runtime.NewVM(&dispatcher)
@@ -14,14 +14,41 @@ const (
 	ARGS = "arguments"
 	TUPLE = "tuple"
 	LIST = "list"
+	MAP = "map"
 	ASSIGNMENT = "assignment"
 	IF = "if"
 	STRING = "string"
+	RAW_STRING = "raw-string"
+	INTERPOLATION = "interpolation"
+	FORMAT = "format"
+	BYTES = "bytes"
 	NUM = "numeric"
 	BOOL = "boolean"
 	REF = "reference"
 	OP = "operation"
 	BINOP = "binary-operator"
+	MATCH = "match"
+	CASE = "case"
+	GUARD = "guard"
+	WILDCARD = "wildcard"
+	LIST_PATTERN = "list-pattern"
+	TUPLE_PATTERN = "tuple-pattern"
+	MAP_PATTERN = "map-pattern"
+	ENTRY = "entry"
+	REST = "rest"
+	DEFAULT = "default"
+	NAMED = "named-argument"
+	FOR = "for"
+	WHILE = "while"
+	BREAK = "break"
+	CONTINUE = "continue"
+	TRY = "try"
+	CATCH = "catch"
+	FINALLY = "finally"
+	PROPAGATE = "propagate"
+	RECORD = "record"
+	FIELD = "field"
+	WITH = "with"
 )
 
 type Source struct{
@@ -82,6 +109,91 @@ func (n *Node) List() *List {
 	return &List{n}
 }
 
+func (n *Node) Tuple() *Tuple {
+	sanity.Ensure(n.Type == TUPLE, "Node must be [%s], but was [%s]", TUPLE, n.Type)
+	return &Tuple{n}
+}
+
+func (n *Node) Interpolation() *Interpolation {
+	sanity.Ensure(n.Type == INTERPOLATION, "Node must be [%s], but was [%s]", INTERPOLATION, n.Type)
+	return &Interpolation{n}
+}
+
+func (n *Node) Format() *Format {
+	sanity.Ensure(n.Type == FORMAT, "Node must be [%s], but was [%s]", FORMAT, n.Type)
+	return &Format{n}
+}
+
+func (n *Node) Map() *Map {
+	sanity.Ensure(n.Type == MAP, "Node must be [%s], but was [%s]", MAP, n.Type)
+	return &Map{n}
+}
+
+func (n *Node) Match() *Match {
+	sanity.Ensure(n.Type == MATCH, "Node must be [%s], but was [%s]", MATCH, n.Type)
+	return &Match{n}
+}
+
+func (n *Node) Pattern() *Pattern {
+	sanity.Ensure(n.Type == LIST_PATTERN || n.Type == TUPLE_PATTERN || n.Type == MAP_PATTERN, "Node must be a pattern, but was [%s]", n.Type)
+	return &Pattern{n}
+}
+
+func (n *Node) Entry() *Entry {
+	sanity.Ensure(n.Type == ENTRY, "Node must be [%s], but was [%s]", ENTRY, n.Type)
+	return &Entry{n}
+}
+
+func (n *Node) Rest() *Rest {
+	sanity.Ensure(n.Type == REST, "Node must be [%s], but was [%s]", REST, n.Type)
+	return &Rest{n}
+}
+
+func (n *Node) For() *For {
+	sanity.Ensure(n.Type == FOR, "Node must be [%s], but was [%s]", FOR, n.Type)
+	return &For{n}
+}
+
+func (n *Node) While() *While {
+	sanity.Ensure(n.Type == WHILE, "Node must be [%s], but was [%s]", WHILE, n.Type)
+	return &While{n}
+}
+
+func (n *Node) Try() *Try {
+	sanity.Ensure(n.Type == TRY, "Node must be [%s], but was [%s]", TRY, n.Type)
+	return &Try{n}
+}
+
+func (n *Node) Propagate() *Propagate {
+	sanity.Ensure(n.Type == PROPAGATE, "Node must be [%s], but was [%s]", PROPAGATE, n.Type)
+	return &Propagate{n}
+}
+
+func (n *Node) Record() *Record {
+	sanity.Ensure(n.Type == RECORD, "Node must be [%s], but was [%s]", RECORD, n.Type)
+	return &Record{n}
+}
+
+func (n *Node) Field() *Field {
+	sanity.Ensure(n.Type == FIELD, "Node must be [%s], but was [%s]", FIELD, n.Type)
+	return &Field{n}
+}
+
+func (n *Node) With() *With {
+	sanity.Ensure(n.Type == WITH, "Node must be [%s], but was [%s]", WITH, n.Type)
+	return &With{n}
+}
+
+func (n *Node) Default() *Default {
+	sanity.Ensure(n.Type == DEFAULT, "Node must be [%s], but was [%s]", DEFAULT, n.Type)
+	return &Default{n}
+}
+
+func (n *Node) Named() *Named {
+	sanity.Ensure(n.Type == NAMED, "Node must be [%s], but was [%s]", NAMED, n.Type)
+	return &Named{n}
+}
+
 type Rift struct{
 	node *Node
 }
@@ -131,10 +243,12 @@ type Func struct{
 	node *Node
 }
 
-func (f *Func) Args() []*Ref {
-	var args []*Ref
+// Each is a [REF], a pattern to destructure the argument with, a [DEFAULT] or
+// a [REST] at the end
+func (f *Func) Args() []*Node {
+	var args []*Node
 	for _, arg := range f.node.Values[0].(*Node).Values {
-		args = append(args, arg.(*Node).Ref())
+		args = append(args, arg.(*Node))
 	}
 	return args
 }
@@ -234,8 +348,9 @@ type Assignment struct{
 	node *Node
 }
 
-func (a *Assignment) Ref() *Ref {
-	return a.node.Values[0].(*Node).Ref()
+// A [REF], or a pattern when destructuring
+func (a *Assignment) Target() *Node {
+	return a.node.Values[0].(*Node)
 }
 
 func (a *Assignment) Value() *Node {
@@ -246,21 +361,141 @@ type If struct{
 	node *Node
 }
 
-func (i *If) Condition() interface{} {
-	return i.node.Values[0]
+type Branch struct{
+	Condition *Node
+	Lines     []*Node
 }
 
-func (i *If) Lines() []*Node {
-	return i.node.Values[1].(*Node).Block().Lines()
+// The if and any else-ifs, in order
+func (i *If) Branches() []Branch {
+	var branches []Branch
+	for j := 0; j + 1 < len(i.node.Values); j += 2 {
+		branches = append(branches, Branch{i.node.Values[j].(*Node), i.node.Values[j + 1].(*Node).Block().Lines()})
+	}
+	return branches
 }
 
 func (i *If) ElseLines() []*Node {
-	if len(i.node.Values) == 3 {
-		elsePart := i.node.Values[2].(*Node).Block()
-		return elsePart.Lines()
-	} else {
-		return []*Node{}		
+	if len(i.node.Values) % 2 == 1 {
+		return i.node.Values[len(i.node.Values) - 1].(*Node).Block().Lines()
+	}
+	return []*Node{}
+}
+
+type For struct{
+	node *Node
+}
+
+// A [REF] or a pattern to destructure each value with
+func (f *For) Target() *Node {
+	return f.node.Values[0].(*Node)
+}
+
+func (f *For) Iterable() *Node {
+	return f.node.Values[1].(*Node)
+}
+
+func (f *For) Lines() []*Node {
+	return f.node.Values[2].(*Node).Block().Lines()
+}
+
+type While struct{
+	node *Node
+}
+
+func (w *While) Condition() *Node {
+	return w.node.Values[0].(*Node)
+}
+
+func (w *While) Lines() []*Node {
+	return w.node.Values[1].(*Node).Block().Lines()
+}
+
+type Try struct{
+	node *Node
+}
+
+func (t *Try) Lines() []*Node {
+	return t.node.Values[0].(*Node).Block().Lines()
+}
+
+func (t *Try) part(Type string) *Node {
+	for _, part := range t.node.Values[1:] {
+		if part.(*Node).Type == Type {
+			return part.(*Node)
+		}
+	}
+	return nil
+}
+
+func (t *Try) HasCatch() bool {
+	return t.part(CATCH) != nil
+}
+
+// A [REF] or a pattern to destructure the error with
+func (t *Try) CatchTarget() *Node {
+	return t.part(CATCH).Values[0].(*Node)
+}
+
+func (t *Try) CatchLines() []*Node {
+	return t.part(CATCH).Values[1].(*Node).Block().Lines()
+}
+
+// Nil when there's no finally block
+func (t *Try) FinallyLines() []*Node {
+	if finally := t.part(FINALLY); finally != nil {
+		return finally.Values[0].(*Node).Block().Lines()
+	}
+	return nil
+}
+
+type Propagate struct{
+	node *Node
+}
+
+func (p *Propagate) Value() *Node {
+	return p.node.Values[0].(*Node)
+}
+
+type Record struct{
+	node *Node
+}
+
+func (r *Record) Fields() []string {
+	var fields []string
+	for _, value := range r.node.Values {
+		fields = append(fields, value.(string))
+	}
+	return fields
+}
+
+type Field struct{
+	node *Node
+}
+
+func (f *Field) Value() *Node {
+	return f.node.Values[0].(*Node)
+}
+
+func (f *Field) Name() string {
+	return f.node.Values[1].(string)
+}
+
+// Updates are named arguments, giving each field's new value
+type With struct{
+	node *Node
+}
+
+func (w *With) Value() *Node {
+	return w.node.Values[0].(*Node)
+}
+
+func (w *With) Updates() []*Node {
+	var updates []*Node
+	for _, value := range w.node.Values[1:] {
+		updates = append(updates, value.(*Node))
 	}
+	return updates
 }
 
 type Operation struct{
@@ -291,6 +526,53 @@ func (l *List) Values() []*Node {
 	return values
 }
 
+type Map struct{
+	node *Node
+}
+
+type MapEntry struct{
+	Key   *Node
+	Value *Node
+}
+
+// Keys and values alternate in the node
+func (m *Map) Entries() []MapEntry {
+	var entries []MapEntry
+	for i := 0; i < len(m.node.Values); i += 2 {
+		entries = append(entries, MapEntry{m.node.Values[i].(*Node), m.node.Values[i + 1].(*Node)})
+	}
+	return entries
+}
+
+type Interpolation struct{
+	node *Node
+}
+
+// Each part is either a [STRING] or a [FORMAT] holding an expression
+func (i *Interpolation) Parts() []*Node {
+	var parts []*Node
+	for _, part := range i.node.Values {
+		parts = append(parts, part.(*Node))
+	}
+	return parts
+}
+
+type Format struct{
+	node *Node
+}
+
+func (f *Format) Value() *Node {
+	return f.node.Values[0].(*Node)
+}
+
+// Empty when no format was given
+func (f *Format) Spec() string {
+	if len(f.node.Values) == 2 {
+		return f.node.Values[1].(string)
+	}
+	return ""
+}
+
 type ListAccess struct{
 	node *Node
 }
@@ -302,3 +584,129 @@ func (la *ListAccess) List() *List {
 func (la *ListAccess) Index() *Node {
 	return la.node.Values[1].(*Node)
 }
+
+type Match struct{
+	node *Node
+}
+
+func (m *Match) Subject() *Node {
+	return m.node.Values[0].(*Node)
+}
+
+func (m *Match) Cases() []*Case {
+	var cases []*Case
+	for _, c := range m.node.Values[1:] {
+		cases = append(cases, &Case{c.(*Node)})
+	}
+	return cases
+}
+
+// Values aren't typed, so no mix of list, map or literal patterns covers every
+// value, and only a pattern that can't fail does. Cases for both booleans are
+// also taken as covering everything, as the subject is then surely a boolean.
+func (m *Match) IsExhaustive() bool {
+	covered := make(map[string]bool)
+	for _, c := range m.Cases() {
+		if c.Guard() != nil {
+			continue
+		}
+		pattern := c.Pattern()
+		switch pattern.Type {
+		case WILDCARD, REF:
+			return true
+		case BOOL:
+			covered[pattern.Values[0].(string)] = true
+		}
+	}
+	return covered["true"] && covered["false"]
+}
+
+type Case struct{
+	node *Node
+}
+
+func (c *Case) Pattern() *Node {
+	return c.node.Values[0].(*Node)
+}
+
+// Nil when the case has no guard
+func (c *Case) Guard() *Node {
+	if len(c.node.Values) == 3 {
+		return c.node.Values[1].(*Node).Values[0].(*Node)
+	}
+	return nil
+}
+
+func (c *Case) Lines() []*Node {
+	body := c.node.Values[len(c.node.Values) - 1].(*Node)
+	if body.Type == BLOCK {
+		return body.Block().Lines()
+	}
+	return []*Node{body}
+}
+
+type Pattern struct{
+	node *Node
+}
+
+// Entries for map patterns, and patterns or rests for the others
+func (p *Pattern) Elements() []*Node {
+	var elements []*Node
+	for _, element := range p.node.Values {
+		elements = append(elements, element.(*Node))
+	}
+	return elements
+}
+
+type Entry struct{
+	node *Node
+}
+
+// Shorthand entries like {name} are keyed by the name
+func (e *Entry) Key() interface{} {
+	if len(e.node.Values) == 1 {
+		return e.node.Values[0].(*Node).Ref().String()
+	}
+	return e.node.Values[0].(*Node)
+}
+
+func (e *Entry) Pattern() *Node {
+	return e.node.Values[len(e.node.Values) - 1].(*Node)
+}
+
+type Rest struct{
+	node *Node
+}
+
+// Either a [REF] or a [WILDCARD]
+func (r *Rest) Binding() *Node {
+	return r.node.Values[0].(*Node)
+}
+
+type Default struct{
+	node *Node
+}
+
+func (d *Default) Target() *Node {
+	return d.node.Values[0].(*Node)
+}
+
+func (d *Default) Ref() *Ref {
+	return d.Target().Ref()
+}
+
+func (d *Default) Value() *Node {
+	return d.node.Values[1].(*Node)
+}
+
+type Named struct{
+	node *Node
+}
+
+func (n *Named) Name() string {
+	return n.node.Values[0].(string)
+}
+
+func (n *Named) Value() *Node {
+	return n.node.Values[1].(*Node)
+}
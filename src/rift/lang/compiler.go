@@ -21,7 +21,7 @@ func Compile(rifts []*Node) {
 			switch line.Type{
 			case ASSIGNMENT:
 				assignment := line.Assignment()
-				logging.Info("Assigning to ref [%s] the value [%s]", assignment.Ref(), assignment.Value())
+				logging.Info("Assigning to [%s] the value [%s]", ToLisp(assignment.Target()), ToLisp(assignment.Value()))
 			case FUNCAPPLY:
 				funcApply := line.FuncApply()
 				logging.Info("Apply func [%s] with args [%s]", funcApply.Ref(), funcApply.Args())
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"rift/support/collections"
 	"strings"
+	"unicode"
 )
 
 func Parse(source io.Reader) (*riftParser, error) {
@@ -18,9 +19,13 @@ func Parse(source io.Reader) (*riftParser, error) {
 	parser.Init()
 	err := parser.Parse()
 	if err != nil {
+		parser.syntaxErr, _ = err.(*parseError)
 		return parser, err
 	}
 	parser.Execute()
+	if len(parser.invalid) > 0 {
+		return parser, parser.invalidLiteralError()
+	}
 
 	return parser, nil
 }
@@ -28,8 +33,9 @@ func Parse(source io.Reader) (*riftParser, error) {
 // TODO: Can this work any better?
 func GetSyntaxErrors(p *riftParser) string {
 	var errors []string
-	for _, err := range p.Error() {
-		pos := translatePositions(p.Buffer, []int{int(err.begin), int(err.end)})[0]
+	if p.syntaxErr != nil {
+		begin := int(p.syntaxErr.max.begin)
+		pos := translatePositions(p.buffer, []int{begin})[begin]
 		errors = append(errors, fmt.Sprintf("Line %d, character %d", pos.line, pos.symbol))
 	}
 	return strings.Join(errors, "\n")
@@ -38,6 +44,44 @@ func GetSyntaxErrors(p *riftParser) string {
 type parseStack struct{
 	source Node
 	stack collections.Stack
+	syntaxErr *parseError
+	interpolating int
+	invalid []invalidLiteral
+	warnings []invalidLiteral
+	matches []int
+}
+
+// Problems the grammar lets through, to be reported once parsing is done
+type invalidLiteral struct{
+	offset  int
+	problem string
+}
+
+func (p *riftParser) invalidLiteralError() error {
+	return fmt.Errorf("%s", strings.Join(p.describe(p.invalid), "\n"))
+}
+
+// Things that are allowed, but probably not what was meant
+func (p *riftParser) Warnings() []string {
+	return p.describe(p.warnings)
+}
+
+func (p *riftParser) describe(problems []invalidLiteral) []string {
+	if len(problems) == 0 {
+		return nil
+	}
+	var offsets []int
+	for _, problem := range problems {
+		offsets = append(offsets, problem.offset)
+	}
+	positions := translatePositions(p.buffer, offsets)
+
+	var described []string
+	for _, problem := range problems {
+		pos := positions[problem.offset]
+		described = append(described, fmt.Sprintf("%s (line %d, character %d)", problem.problem, pos.line, pos.symbol))
+	}
+	return described
 }
 
 func (s *parseStack) Start(Type string) {
@@ -54,6 +98,52 @@ func (s *parseStack) Emit(value string) {
 	top.Add(value)
 }
 
+// Offsets are where the literal's text starts in the source
+func (s *parseStack) EmitString(value string, offset int) {
+	s.checkEscapes(value, offset, false)
+	s.Emit(value)
+}
+
+func (s *parseStack) EmitBytes(value string, offset int) {
+	s.checkEscapes(value, offset, true)
+	s.Emit(value)
+}
+
+// Only the verb is left to check, as the grammar takes care of the rest
+func (s *parseStack) EmitFormat(spec string, offset int) {
+	if spec != "" {
+		verb := rune(spec[len(spec)-1])
+		if unicode.IsLetter(verb) && !strings.ContainsRune(FORMAT_VERBS, verb) {
+			s.invalid = append(s.invalid, invalidLiteral{offset + len(spec) - 1, fmt.Sprintf("Invalid format [%s]", spec)})
+		}
+	}
+	s.Emit(spec)
+}
+
+// Offset is just after the opening `${`
+func (s *parseStack) InvalidInterpolation(contents string, offset int) {
+	s.invalid = append(s.invalid, invalidLiteral{offset - 2, fmt.Sprintf("Malformed interpolation [${%s}]", contents)})
+}
+
+// Matches nest, so their offsets are kept until the match is done
+func (s *parseStack) StartMatch(offset int) {
+	s.matches = append(s.matches, offset)
+}
+
+func (s *parseStack) EndMatch() {
+	offset := s.matches[len(s.matches) - 1]
+	s.matches = s.matches[:len(s.matches) - 1]
+	if !s.stack.Peek().(*Node).Match().IsExhaustive() {
+		s.warnings = append(s.warnings, invalidLiteral{offset, "Match may not cover every value"})
+	}
+}
+
+func (s *parseStack) checkEscapes(value string, offset int, binary bool) {
+	if _, invalid := unescape(value, binary); invalid != nil {
+		s.invalid = append(s.invalid, invalidLiteral{offset + invalid.offset, fmt.Sprintf("Invalid escape [%s]", invalid.escape)})
+	}
+}
+
 func (s *parseStack) EmitNode(value *Node) {
 	var top *Node
 	if s.stack.Len() > 0 {
@@ -64,6 +154,33 @@ func (s *parseStack) EmitNode(value *Node) {
 	top.Add(value)
 }
 
+// For postfix operators, which only show up after what they apply to has been
+// emitted
+func (s *parseStack) Wrap(Type string, values ...interface{}) {
+	var top *Node
+	if s.stack.Len() > 0 {
+		top = s.stack.Peek().(*Node)
+	} else {
+		top = &s.source
+	}
+	last := len(top.Values) - 1
+	top.Values[last] = &Node{Type: Type, Values: append([]interface{}{top.Values[last]}, values...)}
+}
+
+// Like Wrap, but leaves the new node open for what follows, until End
+func (s *parseStack) StartWrap(Type string) {
+	var top *Node
+	if s.stack.Len() > 0 {
+		top = s.stack.Peek().(*Node)
+	} else {
+		top = &s.source
+	}
+	last := len(top.Values) - 1
+	wrapped := &Node{Type: Type, Values: []interface{}{top.Values[last]}}
+	top.Values = top.Values[:last]
+	s.stack.Push(wrapped)
+}
+
 func (s *parseStack) End() {
 	popped := s.stack.Pop()
 	s.EmitNode(popped.(*Node))
@@ -126,5 +243,5 @@ func (s *parseStack) Rifts() []*Node {
 }
 
 func (s *parseStack) Lisp() string {
-	return fmt.Sprintf(ToLisp(s))
+	return ToLisp(s)
 }
\ No newline at end of file
@@ -0,0 +1,125 @@
+package lang
+
+import (
+	"strings"
+	"testing"
+)
+
+// Parses the line as the only line of a rift, giving back what it parsed to
+func parseLine(t *testing.T, line string) *Node {
+	parsed, err := Parse(strings.NewReader("@main => {\n\t" + line + "\n}\n"))
+	if err != nil {
+		t.Fatalf("Couldn't parse [%s]: %v", line, err)
+	}
+	return parsed.Rifts()[0].Rift().Lines()[0]
+}
+
+func parseFailure(line string) error {
+	_, err := Parse(strings.NewReader("@main => {\n\t" + line + "\n}\n"))
+	return err
+}
+
+func TestFormatSpecs(t *testing.T) {
+	for _, c := range []struct{
+		source string
+		value  string
+		spec   string
+	}{
+		{`"${n:x}"`, "n", "x"},
+		{`"${n :x}"`, "n", "x"},
+		{`"${n:.2f}"`, "n", ".2f"},
+		{`"${n:-10s}"`, "n", "-10s"},
+		{`"${n}"`, "n", ""},
+		{`"${m:x }"`, "m:x", ""},
+		{`"${m:xs}"`, "m:xs", ""},
+	} {
+		format := parseLine(t, c.source).Interpolation().Parts()[0].Format()
+		if value := format.Value().Ref().String(); value != c.value {
+			t.Errorf("Expected [%s] to interpolate [%s], but got [%s]", c.source, c.value, value)
+		}
+		if spec := format.Spec(); spec != c.spec {
+			t.Errorf("Expected [%s] to have the format [%s], but got [%s]", c.source, c.spec, spec)
+		}
+	}
+
+	call := parseLine(t, `"${std:len(s):d}"`).Interpolation().Parts()[0].Format()
+	if call.Value().Type != FUNCAPPLY || call.Spec() != "d" {
+		t.Errorf("Expected a call formatted with [d], but got [%s] with [%s]", call.Value().Type, call.Spec())
+	}
+}
+
+func TestInvalidFormatSpecs(t *testing.T) {
+	for source, expected := range map[string]string{
+		`"${n:k}"`:  "Invalid format [k]",
+		`"${n :k}"`: "Invalid format [k]",
+		`"${n:5z}"`: "Invalid format [5z]",
+		`"${n:%}"`:  "Malformed interpolation",
+	} {
+		err := parseFailure(source)
+		if err == nil {
+			t.Errorf("Expected [%s] not to parse", source)
+		} else if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected [%s] to fail with [%s], but got [%v]", source, expected, err)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	for _, name := range []string{"x", "_tmp", "sha256", "v2_name", "empty?", "sort!", "iffy", "truest", "format_", "Upper"} {
+		line := parseLine(t, name + " = 1")
+		if target := line.Assignment().Target(); target.Type != REF || target.Ref().String() != name {
+			t.Errorf("Expected [%s] to be assigned, but got [%s]", name, ToString(target))
+		}
+	}
+
+	for _, name := range []string{"crypto:sha256", "uuid:v4", "re:match", "list:sort!", "std:if"} {
+		if ref := parseLine(t, name).Ref(); ref.String() != name {
+			t.Errorf("Expected the reference [%s], but got [%s]", name, ref)
+		}
+	}
+}
+
+// Where a name is used, a trailing `?` only belongs to it in a call
+func TestCalledPredicates(t *testing.T) {
+	for _, name := range []string{"empty?", "str:empty?", "sort!", "list:sort!"} {
+		line := parseLine(t, name + "(xs)")
+		if line.Type != FUNCAPPLY || line.FuncApply().Ref().String() != name {
+			t.Errorf("Expected a call to [%s], but got [%s]", name, ToString(line))
+		}
+	}
+}
+
+func TestInvalidNames(t *testing.T) {
+	for _, name := range []string{"2x", "if", "else", "true", "false", "match", "for", "in", "while", "try", "a?b", "sort!!", "a-b"} {
+		if err := parseFailure(name + " = 1"); err == nil {
+			t.Errorf("Expected [%s] not to be a name", name)
+		}
+	}
+
+	for _, name := range []string{"std:2x", "std:", "2d:x", "if:x", "std:len:x"} {
+		if err := parseFailure(name); err == nil {
+			t.Errorf("Expected [%s] not to be a reference", name)
+		}
+	}
+}
+
+func TestPropagate(t *testing.T) {
+	for _, c := range []struct{
+		source string
+		value  string
+	}{
+		{"r?", REF},
+		{"r ?", REF},
+		{"str:trim?", REF},
+		{"f(x)?", FUNCAPPLY},
+		{"f(x) ?", FUNCAPPLY},
+		{"std:ok(1)?", FUNCAPPLY},
+	} {
+		line := parseLine(t, c.source)
+		if line.Type != PROPAGATE {
+			t.Errorf("Expected [%s] to propagate, but got [%s]", c.source, ToString(line))
+		} else if value := line.Propagate().Value(); value.Type != c.value {
+			t.Errorf("Expected [%s] to propagate a [%s], but got [%s]", c.source, c.value, ToString(value))
+		}
+	}
+}
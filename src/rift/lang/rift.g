@@ -16,69 +16,212 @@ Line       <- Statement / Expr
 
 Expr       <- (!Op Single) / Op
 
-Single     <- If / FuncApply / Value
+Single     <- (If / Match / For / While / Try / Record / FuncApply / Value) Postfix*
+
+# Postfixes apply left to right, as in `line.start.x` or `load(path)?.name`.
+# A trailing `?` gives back the error from the function when a result is an err,
+# and unwraps it otherwise, after a name as in `found?` as much as after a call.
+Postfix    <- sp '?' { p.Wrap(PROPAGATE) }
+            / '.' !'.' <UsedMember> { p.Wrap(FIELD, text) }
+            / sp 'with' !NameChar sp { p.StartWrap(WITH) } Updates { p.End() }
+
+# Fields are given like named arguments, as in `p with {x: 3}`
+Updates    <- '{' sp NamedArg (sp ',' sp NamedArg)* (sp ',')? sp '}'
+
+Record     <- { p.Start(RECORD) } 'record' !NameChar sp '(' sp Field (sp ',' sp Field)* sp ')' { p.End() }
+
+# Fields can't end in `?`, as it would propagate wherever they were used
+Field      <- <!Keyword UsedMember> { p.Emit(text) }
 
 Op         <- { p.Start(OP) } Single (sp BinaryOp sp Expr)+ { p.End() }
 
 # TODO: Break down by operator type? 
 # TODO: Should we even treat operators specially?
-BinaryOp   <- { p.Start(BINOP) } <'**' / '>=' / '<=' / '==' / '+' / '-' / '*' / '/' / '%' / '>' / '<'> { p.Emit(string(buffer[begin:end])) } { p.End() }
+BinaryOp   <- { p.Start(BINOP) } <'..' / '**' / '>=' / '<=' / '==' / '+' / '-' / '*' / '/' / '%' / '>' / '<'> { p.Emit(text) } { p.End() }
+
+# Ifs are expressions, so they're left to Expr
+Statement  <- Assignment / Break / Continue
+
+Assignment <- { p.Start(ASSIGNMENT) } Target sp '=' sp Expr { p.End() }
+
+# Destructuring takes the same patterns as match, failing when they don't fit
+Target     <- ListPat / TuplePat / MapPat / LocalRef
+
+# Else-ifs are kept flat, as pairs of conditions and blocks, with any final
+# else block last. An if gives back nil when no branch is taken.
+If         <- { p.Start(IF) } 'if' !NameChar sp Expr sp Block (sp ElseIf)* (sp 'else' !NameChar sp Block)? { p.End() }
+
+ElseIf     <- 'else' !NameChar sp 'if' !NameChar sp Expr sp Block
+
+# Loops give back nil. The loop variable can be any pattern, like `[k, v]` over
+# a map's entries.
+For        <- { p.Start(FOR) } 'for' !NameChar sp Target sp 'in' !NameChar sp Expr sp Block { p.End() }
+
+While      <- { p.Start(WHILE) } 'while' !NameChar sp Expr sp Block { p.End() }
+
+Break      <- { p.Start(BREAK) } 'break' !NameChar { p.End() }
+
+Continue   <- { p.Start(CONTINUE) } 'continue' !NameChar { p.End() }
+
+# A try gives back its block's value, or the catch block's when it raised. The
+# finally block always runs, but its value is dropped.
+Try        <- { p.Start(TRY) } 'try' !NameChar sp Block ((sp Catch)? sp Finally / sp Catch) { p.End() }
+
+Catch      <- { p.Start(CATCH) } 'catch' !NameChar sp Target sp Block { p.End() }
+
+Finally    <- { p.Start(FINALLY) } 'finally' !NameChar sp Block { p.End() }
+
+# Cases are tried in order, and only warned about when none is sure to match
+Match      <- { p.Start(MATCH) } <'match'> { p.StartMatch(begin) } !NameChar sp Expr sp '{' sp Case (sp ',' sp Case)* (sp ',')? sp '}' { p.EndMatch() } { p.End() }
+
+Case       <- { p.Start(CASE) } Pattern (sp Guard)? sp '->' sp (Block / Expr) { p.End() }
+
+Guard      <- { p.Start(GUARD) } 'if' !NameChar sp Expr { p.End() }
+
+Pattern    <- Wildcard / ListPat / TuplePat / MapPat / PatLiteral / LocalRef
+
+Wildcard   <- { p.Start(WILDCARD) } '_' !NameChar { p.End() }
+
+# Interpolated strings aren't constant, so can't be matched against
+PatLiteral <- Bytes / RawString / TextBlock / PlainStr / Numeric / Boolean
+
+ListPat    <- { p.Start(LIST_PATTERN) } '[' sp (PatElem (sp ',' sp PatElem)* sp)? ']' { p.End() }
+
+TuplePat   <- { p.Start(TUPLE_PATTERN) } '(' sp (PatElem (sp ',' sp PatElem)* sp)? ')' { p.End() }
+
+PatElem    <- RestPat / Pattern
+
+RestPat    <- { p.Start(REST) } '...' (Wildcard / LocalRef) { p.End() }
 
-Statement  <- Assignment / If
+# A lone name is shorthand for the entry keyed by that name, as a string
+MapPat     <- { p.Start(MAP_PATTERN) } '{' sp (MapPatEntry (sp ',' sp MapPatEntry)* sp)? '}' { p.End() }
 
-Assignment <- { p.Start(ASSIGNMENT) } LocalRef sp '=' sp Expr { p.End() }
+MapPatEntry <- { p.Start(ENTRY) } (PatLiteral sp ':' sp Pattern / LocalRef) { p.End() }
 
-If         <- { p.Start(IF) } 'if' sp Expr sp Block (sp 'else' sp Block)? { p.End() }
+# References in expressions are to names in use rather than being given, so
+# a trailing `?` is only part of the name when it's called, as in `empty?(xs)`
+Ref        <- FullRef / UsedRef
 
-Ref        <- FullRef / LocalRef
+# Keywords are fine after the colon, as in `re:match`. At the end of an
+# interpolation, a colon and a lone letter are a format spec, as in `${n:x}`,
+# so a reference like m:x needs a space after it there.
+FullRef    <- { p.Start(REF) } <Name> { p.Emit(text) } ':' !(&{ p.interpolating > 0 } [a-zA-Z] '}') <UsedMember> { p.Emit(text) } { p.End() }
 
-FullRef    <- { p.Start(REF) } <RefChar+> { p.Emit(string(buffer[begin:end])) } ':' <RefChar+> { p.Emit(string(buffer[begin:end])) } { p.End() }
+UsedRef    <- { p.Start(REF) } <!Keyword UsedMember> { p.Emit(text) } { p.End() }
 
-LocalRef   <- { p.Start(REF) } <RefChar+> { p.Emit(string(buffer[begin:end])) } { p.End() }
+LocalRef   <- { p.Start(REF) } <Name> { p.Emit(text) } { p.End() }
+
+# Digits can follow the first character, as in `sha256`. Predicates can end in
+# `?` and mutators in `!`, like `empty?` and `sort!`.
+Name       <- !Keyword Member
+
+Member     <- RefChar NameChar* [?!]?
+
+UsedMember <- RefChar NameChar* ('!' / '?' &'(')?
+
+NameChar   <- RefChar / Digit
 
 RefChar    <- [[a-z_]]
 
+# Keywords only count as whole words, so `iffy` and `truest` are still names
+Keyword    <- ('if' / 'else' / 'true' / 'false' / 'match' / 'for' / 'in' / 'while' / 'break' / 'continue' / 'try' / 'catch' / 'finally' / 'record' / 'with') !NameChar
+
 Value      <- Literal / Ref
 
 Literal    <- Func / Scalar / Vector
 
-Scalar     <- String / Numeric / Boolean
+Scalar     <- Bytes / String / Numeric / Boolean
 
 Vector     <- List / Tuple / Map
 
-String     <- { p.Start(STRING) } '"' <StringChar*> '"' { p.Emit(string(buffer[begin:end])) } { p.End() }
+String     <- RawString / TextBlock / QuotedStr
+
+QuotedStr  <- PlainStr / Interpolated
+
+PlainStr   <- { p.Start(STRING) } '"' <StringChar*> '"' { p.EmitString(text, begin) } { p.End() }
+
+StringChar <- Escape / !'${' ![\"\n\\] .
+
+Interpolated <- { p.Start(INTERPOLATION) } '"' (StrPart / Interp)* '"' { p.End() }
+
+StrPart    <- { p.Start(STRING) } <StringChar+> { p.EmitString(text, begin) } { p.End() }
+
+Interp     <- GoodInterp / BadInterp
+
+# Interpolations are counted while parsing, so that references can tell
+# whether a colon starts a format spec
+GoodInterp <- '${' !{ p.interpolating++ } (InterpBody !{ p.interpolating-- } / !{ p.interpolating-- } &{ false })
+
+InterpBody <- { p.Start(FORMAT) } sp Expr sp (':' <FormatSpec> { p.EmitFormat(text, begin) })? '}' { p.End() }
 
-StringChar <- StringEsc / ![\"\n\\] .
+# Printf style, like `.2f`, `05d`, `-10s` or just `x`
+FormatSpec <- ('-' / '+' / ' ' / '#' / '0')* Digit* ('.' Digit+)? [a-zA-Z]?
 
-StringEsc  <- SimpleEsc
+# Caught so we can point at exactly what's wrong, instead of failing the whole string
+BadInterp  <- '${' <(!'}' !'"' !'\n' .)*> '}'? { p.InvalidInterpolation(text, begin) }
 
-SimpleEsc  <- '\\' ['\"?\\abfnrtv]
+# Triple-quoted strings can span lines and hold lone quotes, but still have escapes
+TextBlock  <- { p.Start(STRING) } '"""' <BlockChar*> '"""' { p.EmitString(text, begin) } { p.End() }
 
-Numeric    <- { p.Start(NUM) } (SciNum / Decimal / Integer) { p.End() }
+BlockChar  <- Escape / !'"""' !'\\' .
 
-SciNum     <- Decimal [[e]] Integer
+# Raw strings are taken exactly as written, including any newlines
+RawString  <- { p.Start(RAW_STRING) } '`' <(!'`' .)*> '`' { p.Emit(text) } { p.End() }
 
-Decimal    <- Integer '.' <Digit*> { p.Emit(string(buffer[begin:end])) }
+Bytes      <- { p.Start(BYTES) } 'b"' <StringChar*> '"' { p.EmitBytes(text, begin) } { p.End() }
 
-Integer    <- <WholeNum> { p.Emit(string(buffer[begin:end])) }
+# Any character may follow a backslash here, so that invalid escapes are
+# reported as such when the literal is emitted, rather than as a syntax error
+Escape     <- '\\' .
 
-WholeNum   <- '0' / '-'? [1-9] Digit*
+Numeric    <- { p.Start(NUM) } <SciNum / Decimal / Integer> { p.Emit(text) } { p.End() }
+
+SciNum     <- (Decimal / Integer) [[e]] [-+]? Digit+
+
+# Not followed by another dot, so that `0..n` is a range
+Decimal    <- Integer '.' !'.' Digit*
+
+Integer    <- '-'? WholeNum
+
+WholeNum   <- '0' / [1-9] Digit*
 
 Digit      <- [0-9]
 
-Boolean    <- { p.Start(BOOL) } <'true' / 'false'> { p.Emit(string(buffer[begin:end])) } { p.End() }
+Boolean    <- { p.Start(BOOL) } <('true' / 'false') !NameChar> { p.Emit(text) } { p.End() }
 
 Func       <- { p.Start(FUNC) } FuncArgs sp '->' sp (Block / Expr)  { p.End() }
 
-FuncArgs   <- { p.Start(ARGS) } '(' sp (LocalRef (sp ',' sp LocalRef)* sp)? ')' { p.End() }
+FuncArgs   <- { p.Start(ARGS) } '(' sp (Params sp)? ')' { p.End() }
+
+# A rest parameter can only come last
+Params     <- RestPat / Param (sp ',' sp Param)* (sp ',' sp RestPat)?
+
+Param      <- DefaultArg / Target
+
+# Defaults are evaluated when called, and can refer to earlier parameters
+DefaultArg <- { p.Start(DEFAULT) } LocalRef sp '=' sp Expr { p.End() }
+
+FuncApply  <- { p.Start(FUNCAPPLY) } Ref CallArgs { p.End() }
+
+# Named arguments come after positional ones. Unlike in `f(std:len)`, the colon
+# can't be followed straight away by a name.
+CallArgs   <- { p.Start(TUPLE) } '(' sp (CallArgList sp)? ')' { p.End() }
+
+CallArgList <- NamedArgs / PosArg (sp ',' sp PosArg)* (sp ',' sp NamedArgs)?
+
+PosArg     <- !ArgName Expr
+
+NamedArgs  <- NamedArg (sp ',' sp NamedArg)*
+
+NamedArg   <- { p.Start(NAMED) } ArgName sp Expr { p.End() }
 
-FuncApply  <- { p.Start(FUNCAPPLY) } Ref Tuple { p.End() }
+ArgName    <- <Name> { p.Emit(text) } sp ':' !RefChar
 
 List       <- { p.Start(LIST) } '[' sp (Expr (sp ',' sp Expr)* sp)? ']' { p.End() }
 
 Tuple      <- { p.Start(TUPLE) } '(' sp (Expr (sp ',' sp Expr)* sp)? ')' { p.End() }
 
-Map        <- { p.Start("map") } '{' sp (Expr sp ':' sp Expr (sp ',' sp Expr sp ':' sp Expr)* sp)? '}' { p.End() }
+Map        <- { p.Start(MAP) } '{' sp (Expr sp ':' sp Expr (sp ',' sp Expr sp ':' sp Expr)* sp)? '}' { p.End() }
 
 Gravitasse <- '@'
 
@@ -1,13 +1,17 @@
 package lang
 
+// Code generated by bin/peg -inline -switch src/rift/lang/rift.g DO NOT EDIT.
+
 import (
 	"fmt"
-	"math"
+	"io"
+	"os"
 	"sort"
 	"strconv"
+	"strings"
 )
 
-const end_symbol rune = 4
+const endSymbol rune = 1114112
 
 /* The rule types inferred from the grammar are below. */
 type pegRule uint8
@@ -20,23 +24,66 @@ const (
 	ruleLine
 	ruleExpr
 	ruleSingle
+	rulePostfix
+	ruleUpdates
+	ruleRecord
+	ruleField
 	ruleOp
 	ruleBinaryOp
 	ruleStatement
 	ruleAssignment
+	ruleTarget
 	ruleIf
+	ruleElseIf
+	ruleFor
+	ruleWhile
+	ruleBreak
+	ruleContinue
+	ruleTry
+	ruleCatch
+	ruleFinally
+	ruleMatch
+	ruleCase
+	ruleGuard
+	rulePattern
+	ruleWildcard
+	rulePatLiteral
+	ruleListPat
+	ruleTuplePat
+	rulePatElem
+	ruleRestPat
+	ruleMapPat
+	ruleMapPatEntry
 	ruleRef
 	ruleFullRef
+	ruleUsedRef
 	ruleLocalRef
+	ruleName
+	ruleMember
+	ruleUsedMember
+	ruleNameChar
 	ruleRefChar
+	ruleKeyword
 	ruleValue
 	ruleLiteral
 	ruleScalar
 	ruleVector
 	ruleString
+	ruleQuotedStr
+	rulePlainStr
 	ruleStringChar
-	ruleStringEsc
-	ruleSimpleEsc
+	ruleInterpolated
+	ruleStrPart
+	ruleInterp
+	ruleGoodInterp
+	ruleInterpBody
+	ruleFormatSpec
+	ruleBadInterp
+	ruleTextBlock
+	ruleBlockChar
+	ruleRawString
+	ruleBytes
+	ruleEscape
 	ruleNumeric
 	ruleSciNum
 	ruleDecimal
@@ -46,7 +93,16 @@ const (
 	ruleBoolean
 	ruleFunc
 	ruleFuncArgs
+	ruleParams
+	ruleParam
+	ruleDefaultArg
 	ruleFuncApply
+	ruleCallArgs
+	ruleCallArgList
+	rulePosArg
+	ruleNamedArgs
+	ruleNamedArg
+	ruleArgName
 	ruleList
 	ruleTuple
 	ruleMap
@@ -60,9 +116,9 @@ const (
 	ruleAction2
 	ruleAction3
 	ruleAction4
+	rulePegText
 	ruleAction5
 	ruleAction6
-	rulePegText
 	ruleAction7
 	ruleAction8
 	ruleAction9
@@ -98,10 +154,74 @@ const (
 	ruleAction39
 	ruleAction40
 	ruleAction41
-
-	rulePre_
-	rule_In_
-	rule_Suf
+	ruleAction42
+	ruleAction43
+	ruleAction44
+	ruleAction45
+	ruleAction46
+	ruleAction47
+	ruleAction48
+	ruleAction49
+	ruleAction50
+	ruleAction51
+	ruleAction52
+	ruleAction53
+	ruleAction54
+	ruleAction55
+	ruleAction56
+	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
+	ruleAction68
+	ruleAction69
+	ruleAction70
+	ruleAction71
+	ruleAction72
+	ruleAction73
+	ruleAction74
+	ruleAction75
+	ruleAction76
+	ruleAction77
+	ruleAction78
+	ruleAction79
+	ruleAction80
+	ruleAction81
+	ruleAction82
+	ruleAction83
+	ruleAction84
+	ruleAction85
+	ruleAction86
+	ruleAction87
+	ruleAction88
+	ruleAction89
+	ruleAction90
+	ruleAction91
+	ruleAction92
+	ruleAction93
+	ruleAction94
+	ruleAction95
+	ruleAction96
+	ruleAction97
+	ruleAction98
+	ruleAction99
+	ruleAction100
+	ruleAction101
+	ruleAction102
+	ruleAction103
+	ruleAction104
+	ruleAction105
+	ruleAction106
+	ruleAction107
+	ruleAction108
+	ruleAction109
 )
 
 var rul3s = [...]string{
@@ -112,23 +232,66 @@ var rul3s = [...]string{
 	"Line",
 	"Expr",
 	"Single",
+	"Postfix",
+	"Updates",
+	"Record",
+	"Field",
 	"Op",
 	"BinaryOp",
 	"Statement",
 	"Assignment",
+	"Target",
 	"If",
+	"ElseIf",
+	"For",
+	"While",
+	"Break",
+	"Continue",
+	"Try",
+	"Catch",
+	"Finally",
+	"Match",
+	"Case",
+	"Guard",
+	"Pattern",
+	"Wildcard",
+	"PatLiteral",
+	"ListPat",
+	"TuplePat",
+	"PatElem",
+	"RestPat",
+	"MapPat",
+	"MapPatEntry",
 	"Ref",
 	"FullRef",
+	"UsedRef",
 	"LocalRef",
+	"Name",
+	"Member",
+	"UsedMember",
+	"NameChar",
 	"RefChar",
+	"Keyword",
 	"Value",
 	"Literal",
 	"Scalar",
 	"Vector",
 	"String",
+	"QuotedStr",
+	"PlainStr",
 	"StringChar",
-	"StringEsc",
-	"SimpleEsc",
+	"Interpolated",
+	"StrPart",
+	"Interp",
+	"GoodInterp",
+	"InterpBody",
+	"FormatSpec",
+	"BadInterp",
+	"TextBlock",
+	"BlockChar",
+	"RawString",
+	"Bytes",
+	"Escape",
 	"Numeric",
 	"SciNum",
 	"Decimal",
@@ -138,7 +301,16 @@ var rul3s = [...]string{
 	"Boolean",
 	"Func",
 	"FuncArgs",
+	"Params",
+	"Param",
+	"DefaultArg",
 	"FuncApply",
+	"CallArgs",
+	"CallArgList",
+	"PosArg",
+	"NamedArgs",
+	"NamedArg",
+	"ArgName",
 	"List",
 	"Tuple",
 	"Map",
@@ -152,9 +324,9 @@ var rul3s = [...]string{
 	"Action2",
 	"Action3",
 	"Action4",
+	"PegText",
 	"Action5",
 	"Action6",
-	"PegText",
 	"Action7",
 	"Action8",
 	"Action9",
@@ -190,22 +362,83 @@ var rul3s = [...]string{
 	"Action39",
 	"Action40",
 	"Action41",
+	"Action42",
+	"Action43",
+	"Action44",
+	"Action45",
+	"Action46",
+	"Action47",
+	"Action48",
+	"Action49",
+	"Action50",
+	"Action51",
+	"Action52",
+	"Action53",
+	"Action54",
+	"Action55",
+	"Action56",
+	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
+	"Action68",
+	"Action69",
+	"Action70",
+	"Action71",
+	"Action72",
+	"Action73",
+	"Action74",
+	"Action75",
+	"Action76",
+	"Action77",
+	"Action78",
+	"Action79",
+	"Action80",
+	"Action81",
+	"Action82",
+	"Action83",
+	"Action84",
+	"Action85",
+	"Action86",
+	"Action87",
+	"Action88",
+	"Action89",
+	"Action90",
+	"Action91",
+	"Action92",
+	"Action93",
+	"Action94",
+	"Action95",
+	"Action96",
+	"Action97",
+	"Action98",
+	"Action99",
+	"Action100",
+	"Action101",
+	"Action102",
+	"Action103",
+	"Action104",
+	"Action105",
+	"Action106",
+	"Action107",
+	"Action108",
+	"Action109",
+}
 
-	"Pre_",
-	"_In_",
-	"_Suf",
+type token32 struct {
+	pegRule
+	begin, end uint32
 }
 
-type tokenTree interface {
-	Print()
-	PrintSyntax()
-	PrintSyntaxTree(buffer string)
-	Add(rule pegRule, begin, end, next, depth int)
-	Expand(index int) tokenTree
-	Tokens() <-chan token32
-	AST() *node32
-	Error() []token32
-	trim(length int)
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
 }
 
 type node32 struct {
@@ -213,305 +446,43 @@ type node32 struct {
 	up, next *node32
 }
 
-func (node *node32) print(depth int, buffer string) {
-	for node != nil {
-		for c := 0; c < depth; c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[node.pegRule], strconv.Quote(string(([]rune(buffer)[node.begin:node.end]))))
-		if node.up != nil {
-			node.up.print(depth+1, buffer)
-		}
-		node = node.next
-	}
-}
-
-func (ast *node32) Print(buffer string) {
-	ast.print(0, buffer)
-}
-
-type element struct {
-	node *node32
-	down *element
-}
-
-/* ${@} bit structure for abstract syntax tree */
-type token16 struct {
-	pegRule
-	begin, end, next int16
-}
-
-func (t *token16) isZero() bool {
-	return t.pegRule == ruleUnknown && t.begin == 0 && t.end == 0 && t.next == 0
-}
-
-func (t *token16) isParentOf(u token16) bool {
-	return t.begin <= u.begin && t.end >= u.end && t.next > u.next
-}
-
-func (t *token16) getToken32() token32 {
-	return token32{pegRule: t.pegRule, begin: int32(t.begin), end: int32(t.end), next: int32(t.next)}
-}
-
-func (t *token16) String() string {
-	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v %v", rul3s[t.pegRule], t.begin, t.end, t.next)
-}
-
-type tokens16 struct {
-	tree    []token16
-	ordered [][]token16
-}
-
-func (t *tokens16) trim(length int) {
-	t.tree = t.tree[0:length]
-}
-
-func (t *tokens16) Print() {
-	for _, token := range t.tree {
-		fmt.Println(token.String())
-	}
-}
-
-func (t *tokens16) Order() [][]token16 {
-	if t.ordered != nil {
-		return t.ordered
-	}
-
-	depths := make([]int16, 1, math.MaxInt16)
-	for i, token := range t.tree {
-		if token.pegRule == ruleUnknown {
-			t.tree = t.tree[:i]
-			break
-		}
-		depth := int(token.next)
-		if length := len(depths); depth >= length {
-			depths = depths[:depth+1]
-		}
-		depths[depth]++
-	}
-	depths = append(depths, 0)
-
-	ordered, pool := make([][]token16, len(depths)), make([]token16, len(t.tree)+len(depths))
-	for i, depth := range depths {
-		depth++
-		ordered[i], pool, depths[i] = pool[:depth], pool[depth:], 0
-	}
-
-	for i, token := range t.tree {
-		depth := token.next
-		token.next = int16(i)
-		ordered[depth][depths[depth]] = token
-		depths[depth]++
-	}
-	t.ordered = ordered
-	return ordered
-}
-
-type state16 struct {
-	token16
-	depths []int16
-	leaf   bool
-}
-
-func (t *tokens16) AST() *node32 {
-	tokens := t.Tokens()
-	stack := &element{node: &node32{token32: <-tokens}}
-	for token := range tokens {
-		if token.begin == token.end {
-			continue
-		}
-		node := &node32{token32: token}
-		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
-			stack.node.next = node.up
-			node.up = stack.node
-			stack = stack.down
-		}
-		stack = &element{node: node, down: stack}
-	}
-	return stack.node
-}
-
-func (t *tokens16) PreOrder() (<-chan state16, [][]token16) {
-	s, ordered := make(chan state16, 6), t.Order()
-	go func() {
-		var states [8]state16
-		for i, _ := range states {
-			states[i].depths = make([]int16, len(ordered))
-		}
-		depths, state, depth := make([]int16, len(ordered)), 0, 1
-		write := func(t token16, leaf bool) {
-			S := states[state]
-			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, int16(depth), leaf
-			copy(S.depths, depths)
-			s <- S
-		}
-
-		states[state].token16 = ordered[0][0]
-		depths[0]++
-		state++
-		a, b := ordered[depth-1][depths[depth-1]-1], ordered[depth][depths[depth]]
-	depthFirstSearch:
-		for {
-			for {
-				if i := depths[depth]; i > 0 {
-					if c, j := ordered[depth][i-1], depths[depth-1]; a.isParentOf(c) &&
-						(j < 2 || !ordered[depth-1][j-2].isParentOf(c)) {
-						if c.end != b.begin {
-							write(token16{pegRule: rule_In_, begin: c.end, end: b.begin}, true)
-						}
-						break
-					}
-				}
-
-				if a.begin < b.begin {
-					write(token16{pegRule: rulePre_, begin: a.begin, end: b.begin}, true)
-				}
-				break
-			}
-
-			next := depth + 1
-			if c := ordered[next][depths[next]]; c.pegRule != ruleUnknown && b.isParentOf(c) {
-				write(b, false)
-				depths[depth]++
-				depth, a, b = next, b, c
-				continue
-			}
-
-			write(b, true)
-			depths[depth]++
-			c, parent := ordered[depth][depths[depth]], true
-			for {
-				if c.pegRule != ruleUnknown && a.isParentOf(c) {
-					b = c
-					continue depthFirstSearch
-				} else if parent && b.end != a.end {
-					write(token16{pegRule: rule_Suf, begin: b.end, end: a.end}, true)
-				}
-
-				depth--
-				if depth > 0 {
-					a, b, c = ordered[depth-1][depths[depth-1]-1], a, ordered[depth][depths[depth]]
-					parent = a.isParentOf(b)
-					continue
-				}
-
-				break depthFirstSearch
-			}
-		}
-
-		close(s)
-	}()
-	return s, ordered
-}
-
-func (t *tokens16) PrintSyntax() {
-	tokens, ordered := t.PreOrder()
-	max := -1
-	for token := range tokens {
-		if !token.leaf {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[36m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
 			}
-			fmt.Printf(" \x1B[36m%v\x1B[m\n", rul3s[token.pegRule])
-		} else if token.begin == token.end {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[31m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
 			}
-			fmt.Printf(" \x1B[31m%v\x1B[m\n", rul3s[token.pegRule])
-		} else {
-			for c, end := token.begin, token.end; c < end; c++ {
-				if i := int(c); max+1 < i {
-					for j := max; j < i; j++ {
-						fmt.Printf("skip %v %v\n", j, token.String())
-					}
-					max = i
-				} else if i := int(c); i <= max {
-					for j := i; j <= max; j++ {
-						fmt.Printf("dupe %v %v\n", j, token.String())
-					}
-				} else {
-					max = int(c)
-				}
-				fmt.Printf("%v", c)
-				for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-					fmt.Printf(" \x1B[34m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-				}
-				fmt.Printf(" \x1B[34m%v\x1B[m\n", rul3s[token.pegRule])
+			if node.up != nil {
+				print(node.up, depth+1)
 			}
-			fmt.Printf("\n")
-		}
-	}
-}
-
-func (t *tokens16) PrintSyntaxTree(buffer string) {
-	tokens, _ := t.PreOrder()
-	for token := range tokens {
-		for c := 0; c < int(token.next); c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(string(([]rune(buffer)[token.begin:token.end]))))
-	}
-}
-
-func (t *tokens16) Add(rule pegRule, begin, end, depth, index int) {
-	t.tree[index] = token16{pegRule: rule, begin: int16(begin), end: int16(end), next: int16(depth)}
-}
-
-func (t *tokens16) Tokens() <-chan token32 {
-	s := make(chan token32, 16)
-	go func() {
-		for _, v := range t.tree {
-			s <- v.getToken32()
-		}
-		close(s)
-	}()
-	return s
-}
-
-func (t *tokens16) Error() []token32 {
-	ordered := t.Order()
-	length := len(ordered)
-	tokens, length := make([]token32, length), length-1
-	for i, _ := range tokens {
-		o := ordered[length-i]
-		if len(o) > 1 {
-			tokens[i] = o[len(o)-2].getToken32()
+			node = node.next
 		}
 	}
-	return tokens
-}
-
-/* ${@} bit structure for abstract syntax tree */
-type token32 struct {
-	pegRule
-	begin, end, next int32
-}
-
-func (t *token32) isZero() bool {
-	return t.pegRule == ruleUnknown && t.begin == 0 && t.end == 0 && t.next == 0
-}
-
-func (t *token32) isParentOf(u token32) bool {
-	return t.begin <= u.begin && t.end >= u.end && t.next > u.next
+	print(node, 0)
 }
 
-func (t *token32) getToken32() token32 {
-	return token32{pegRule: t.pegRule, begin: int32(t.begin), end: int32(t.end), next: int32(t.next)}
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
 }
 
-func (t *token32) String() string {
-	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v %v", rul3s[t.pegRule], t.begin, t.end, t.next)
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
 }
 
 type tokens32 struct {
-	tree    []token32
-	ordered [][]token32
+	tree []token32
 }
 
-func (t *tokens32) trim(length int) {
-	t.tree = t.tree[0:length]
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
 }
 
 func (t *tokens32) Print() {
@@ -520,51 +491,14 @@ func (t *tokens32) Print() {
 	}
 }
 
-func (t *tokens32) Order() [][]token32 {
-	if t.ordered != nil {
-		return t.ordered
-	}
-
-	depths := make([]int32, 1, math.MaxInt16)
-	for i, token := range t.tree {
-		if token.pegRule == ruleUnknown {
-			t.tree = t.tree[:i]
-			break
-		}
-		depth := int(token.next)
-		if length := len(depths); depth >= length {
-			depths = depths[:depth+1]
-		}
-		depths[depth]++
-	}
-	depths = append(depths, 0)
-
-	ordered, pool := make([][]token32, len(depths)), make([]token32, len(t.tree)+len(depths))
-	for i, depth := range depths {
-		depth++
-		ordered[i], pool, depths[i] = pool[:depth], pool[depth:], 0
-	}
-
-	for i, token := range t.tree {
-		depth := token.next
-		token.next = int32(i)
-		ordered[depth][depths[depth]] = token
-		depths[depth]++
-	}
-	t.ordered = ordered
-	return ordered
-}
-
-type state32 struct {
-	token32
-	depths []int32
-	leaf   bool
-}
-
 func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
 	tokens := t.Tokens()
-	stack := &element{node: &node32{token32: <-tokens}}
-	for token := range tokens {
+	var stack *element
+	for _, token := range tokens {
 		if token.begin == token.end {
 			continue
 		}
@@ -576,181 +510,35 @@ func (t *tokens32) AST() *node32 {
 		}
 		stack = &element{node: node, down: stack}
 	}
-	return stack.node
-}
-
-func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
-	s, ordered := make(chan state32, 6), t.Order()
-	go func() {
-		var states [8]state32
-		for i, _ := range states {
-			states[i].depths = make([]int32, len(ordered))
-		}
-		depths, state, depth := make([]int32, len(ordered)), 0, 1
-		write := func(t token32, leaf bool) {
-			S := states[state]
-			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, int32(depth), leaf
-			copy(S.depths, depths)
-			s <- S
-		}
-
-		states[state].token32 = ordered[0][0]
-		depths[0]++
-		state++
-		a, b := ordered[depth-1][depths[depth-1]-1], ordered[depth][depths[depth]]
-	depthFirstSearch:
-		for {
-			for {
-				if i := depths[depth]; i > 0 {
-					if c, j := ordered[depth][i-1], depths[depth-1]; a.isParentOf(c) &&
-						(j < 2 || !ordered[depth-1][j-2].isParentOf(c)) {
-						if c.end != b.begin {
-							write(token32{pegRule: rule_In_, begin: c.end, end: b.begin}, true)
-						}
-						break
-					}
-				}
-
-				if a.begin < b.begin {
-					write(token32{pegRule: rulePre_, begin: a.begin, end: b.begin}, true)
-				}
-				break
-			}
-
-			next := depth + 1
-			if c := ordered[next][depths[next]]; c.pegRule != ruleUnknown && b.isParentOf(c) {
-				write(b, false)
-				depths[depth]++
-				depth, a, b = next, b, c
-				continue
-			}
-
-			write(b, true)
-			depths[depth]++
-			c, parent := ordered[depth][depths[depth]], true
-			for {
-				if c.pegRule != ruleUnknown && a.isParentOf(c) {
-					b = c
-					continue depthFirstSearch
-				} else if parent && b.end != a.end {
-					write(token32{pegRule: rule_Suf, begin: b.end, end: a.end}, true)
-				}
-
-				depth--
-				if depth > 0 {
-					a, b, c = ordered[depth-1][depths[depth-1]-1], a, ordered[depth][depths[depth]]
-					parent = a.isParentOf(b)
-					continue
-				}
-
-				break depthFirstSearch
-			}
-		}
-
-		close(s)
-	}()
-	return s, ordered
-}
-
-func (t *tokens32) PrintSyntax() {
-	tokens, ordered := t.PreOrder()
-	max := -1
-	for token := range tokens {
-		if !token.leaf {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[36m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[36m%v\x1B[m\n", rul3s[token.pegRule])
-		} else if token.begin == token.end {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[31m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[31m%v\x1B[m\n", rul3s[token.pegRule])
-		} else {
-			for c, end := token.begin, token.end; c < end; c++ {
-				if i := int(c); max+1 < i {
-					for j := max; j < i; j++ {
-						fmt.Printf("skip %v %v\n", j, token.String())
-					}
-					max = i
-				} else if i := int(c); i <= max {
-					for j := i; j <= max; j++ {
-						fmt.Printf("dupe %v %v\n", j, token.String())
-					}
-				} else {
-					max = int(c)
-				}
-				fmt.Printf("%v", c)
-				for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-					fmt.Printf(" \x1B[34m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-				}
-				fmt.Printf(" \x1B[34m%v\x1B[m\n", rul3s[token.pegRule])
-			}
-			fmt.Printf("\n")
-		}
+	if stack != nil {
+		return stack.node
 	}
+	return nil
 }
 
 func (t *tokens32) PrintSyntaxTree(buffer string) {
-	tokens, _ := t.PreOrder()
-	for token := range tokens {
-		for c := 0; c < int(token.next); c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(string(([]rune(buffer)[token.begin:token.end]))))
-	}
-}
-
-func (t *tokens32) Add(rule pegRule, begin, end, depth, index int) {
-	t.tree[index] = token32{pegRule: rule, begin: int32(begin), end: int32(end), next: int32(depth)}
+	t.AST().Print(os.Stdout, buffer)
 }
 
-func (t *tokens32) Tokens() <-chan token32 {
-	s := make(chan token32, 16)
-	go func() {
-		for _, v := range t.tree {
-			s <- v.getToken32()
-		}
-		close(s)
-	}()
-	return s
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
 }
 
-func (t *tokens32) Error() []token32 {
-	ordered := t.Order()
-	length := len(ordered)
-	tokens, length := make([]token32, length), length-1
-	for i, _ := range tokens {
-		o := ordered[length-i]
-		if len(o) > 1 {
-			tokens[i] = o[len(o)-2].getToken32()
-		}
-	}
-	return tokens
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
 }
 
-func (t *tokens16) Expand(index int) tokenTree {
-	tree := t.tree
-	if index >= len(tree) {
-		expanded := make([]token32, 2*len(tree))
-		for i, v := range tree {
-			expanded[i] = v.getToken32()
-		}
-		return &tokens32{tree: expanded}
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
 	}
-	return nil
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
 }
 
-func (t *tokens32) Expand(index int) tokenTree {
-	tree := t.tree
-	if index >= len(tree) {
-		expanded := make([]token32, 2*len(tree))
-		copy(expanded, tree)
-		t.tree = expanded
-	}
-	return nil
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
 }
 
 type riftParser struct {
@@ -758,10 +546,19 @@ type riftParser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [85]func() bool
-	Parse  func(rule ...int) error
-	Reset  func()
-	tokenTree
+	rules  [205]func() bool
+	parse  func(rule ...int) error
+	reset  func()
+	Pretty bool
+	tokens32
+}
+
+func (p *riftParser) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *riftParser) Reset() {
+	p.reset()
 }
 
 type textPosition struct {
@@ -770,12 +567,12 @@ type textPosition struct {
 
 type textPositionMap map[int]textPosition
 
-func translatePositions(buffer string, positions []int) textPositionMap {
+func translatePositions(buffer []rune, positions []int) textPositionMap {
 	length, translations, j, line, symbol := len(positions), make(textPositionMap, len(positions)), 0, 1, 0
 	sort.Ints(positions)
 
 search:
-	for i, c := range buffer[0:] {
+	for i, c := range buffer {
 		if c == '\n' {
 			line, symbol = line+1, 0
 		} else {
@@ -796,44 +593,60 @@ search:
 }
 
 type parseError struct {
-	p *riftParser
+	p   *riftParser
+	max token32
 }
 
 func (e *parseError) Error() string {
-	tokens, error := e.p.tokenTree.Error(), "\n"
+	tokens, err := []token32{e.max}, "\n"
 	positions, p := make([]int, 2*len(tokens)), 0
 	for _, token := range tokens {
 		positions[p], p = int(token.begin), p+1
 		positions[p], p = int(token.end), p+1
 	}
-	translations := translatePositions(e.p.Buffer, positions)
+	translations := translatePositions(e.p.buffer, positions)
+	format := "parse error near %v (line %v symbol %v - line %v symbol %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n"
+	}
 	for _, token := range tokens {
 		begin, end := int(token.begin), int(token.end)
-		error += fmt.Sprintf("parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n",
+		err += fmt.Sprintf(format,
 			rul3s[token.pegRule],
 			translations[begin].line, translations[begin].symbol,
 			translations[end].line, translations[end].symbol,
-			/*strconv.Quote(*/ e.p.Buffer[begin:end] /*)*/)
+			strconv.Quote(string(e.p.buffer[begin:end])))
 	}
 
-	return error
+	return err
 }
 
 func (p *riftParser) PrintSyntaxTree() {
-	p.tokenTree.PrintSyntaxTree(p.Buffer)
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *riftParser) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
 }
 
-func (p *riftParser) Highlighter() {
-	p.tokenTree.PrintSyntax()
+func (p *riftParser) SprintSyntaxTree() string {
+	var bldr strings.Builder
+	p.WriteSyntaxTree(&bldr)
+	return bldr.String()
 }
 
 func (p *riftParser) Execute() {
-	buffer, begin, end := p.Buffer, 0, 0
-	for token := range p.tokenTree.Tokens() {
+	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, "", 0, 0
+	for _, token := range p.Tokens() {
 		switch token.pegRule {
 
 		case rulePegText:
 			begin, end = int(token.begin), int(token.end)
+			text = string(_buffer[begin:end])
 
 		case ruleAction0:
 			p.Start(RIFT)
@@ -844,124 +657,286 @@ func (p *riftParser) Execute() {
 		case ruleAction3:
 			p.End()
 		case ruleAction4:
-			p.Start(OP)
+			p.Wrap(PROPAGATE)
 		case ruleAction5:
-			p.End()
+			p.Wrap(FIELD, text)
 		case ruleAction6:
-			p.Start(BINOP)
+			p.StartWrap(WITH)
 		case ruleAction7:
-			p.Emit(string(buffer[begin:end]))
-		case ruleAction8:
 			p.End()
+		case ruleAction8:
+			p.Start(RECORD)
 		case ruleAction9:
-			p.Start(ASSIGNMENT)
-		case ruleAction10:
 			p.End()
+		case ruleAction10:
+			p.Emit(text)
 		case ruleAction11:
-			p.Start(IF)
+			p.Start(OP)
 		case ruleAction12:
 			p.End()
 		case ruleAction13:
-			p.Start(REF)
+			p.Start(BINOP)
 		case ruleAction14:
-			p.Emit(string(buffer[begin:end]))
+			p.Emit(text)
 		case ruleAction15:
-			p.Emit(string(buffer[begin:end]))
-		case ruleAction16:
 			p.End()
+		case ruleAction16:
+			p.Start(ASSIGNMENT)
 		case ruleAction17:
-			p.Start(REF)
+			p.End()
 		case ruleAction18:
-			p.Emit(string(buffer[begin:end]))
+			p.Start(IF)
 		case ruleAction19:
 			p.End()
 		case ruleAction20:
-			p.Start(STRING)
+			p.Start(FOR)
 		case ruleAction21:
-			p.Emit(string(buffer[begin:end]))
-		case ruleAction22:
 			p.End()
+		case ruleAction22:
+			p.Start(WHILE)
 		case ruleAction23:
-			p.Start(NUM)
-		case ruleAction24:
 			p.End()
+		case ruleAction24:
+			p.Start(BREAK)
 		case ruleAction25:
-			p.Emit(string(buffer[begin:end]))
+			p.End()
 		case ruleAction26:
-			p.Emit(string(buffer[begin:end]))
+			p.Start(CONTINUE)
 		case ruleAction27:
-			p.Start(BOOL)
+			p.End()
 		case ruleAction28:
-			p.Emit(string(buffer[begin:end]))
+			p.Start(TRY)
 		case ruleAction29:
 			p.End()
 		case ruleAction30:
-			p.Start(FUNC)
+			p.Start(CATCH)
 		case ruleAction31:
 			p.End()
 		case ruleAction32:
-			p.Start(ARGS)
+			p.Start(FINALLY)
 		case ruleAction33:
 			p.End()
 		case ruleAction34:
-			p.Start(FUNCAPPLY)
+			p.Start(MATCH)
 		case ruleAction35:
-			p.End()
+			p.StartMatch(begin)
 		case ruleAction36:
-			p.Start(LIST)
+			p.EndMatch()
 		case ruleAction37:
 			p.End()
 		case ruleAction38:
-			p.Start(TUPLE)
+			p.Start(CASE)
 		case ruleAction39:
 			p.End()
 		case ruleAction40:
-			p.Start("map")
+			p.Start(GUARD)
 		case ruleAction41:
 			p.End()
+		case ruleAction42:
+			p.Start(WILDCARD)
+		case ruleAction43:
+			p.End()
+		case ruleAction44:
+			p.Start(LIST_PATTERN)
+		case ruleAction45:
+			p.End()
+		case ruleAction46:
+			p.Start(TUPLE_PATTERN)
+		case ruleAction47:
+			p.End()
+		case ruleAction48:
+			p.Start(REST)
+		case ruleAction49:
+			p.End()
+		case ruleAction50:
+			p.Start(MAP_PATTERN)
+		case ruleAction51:
+			p.End()
+		case ruleAction52:
+			p.Start(ENTRY)
+		case ruleAction53:
+			p.End()
+		case ruleAction54:
+			p.Start(REF)
+		case ruleAction55:
+			p.Emit(text)
+		case ruleAction56:
+			p.Emit(text)
+		case ruleAction57:
+			p.End()
+		case ruleAction58:
+			p.Start(REF)
+		case ruleAction59:
+			p.Emit(text)
+		case ruleAction60:
+			p.End()
+		case ruleAction61:
+			p.Start(REF)
+		case ruleAction62:
+			p.Emit(text)
+		case ruleAction63:
+			p.End()
+		case ruleAction64:
+			p.Start(STRING)
+		case ruleAction65:
+			p.EmitString(text, begin)
+		case ruleAction66:
+			p.End()
+		case ruleAction67:
+			p.Start(INTERPOLATION)
+		case ruleAction68:
+			p.End()
+		case ruleAction69:
+			p.Start(STRING)
+		case ruleAction70:
+			p.EmitString(text, begin)
+		case ruleAction71:
+			p.End()
+		case ruleAction72:
+			p.Start(FORMAT)
+		case ruleAction73:
+			p.EmitFormat(text, begin)
+		case ruleAction74:
+			p.End()
+		case ruleAction75:
+			p.InvalidInterpolation(text, begin)
+		case ruleAction76:
+			p.Start(STRING)
+		case ruleAction77:
+			p.EmitString(text, begin)
+		case ruleAction78:
+			p.End()
+		case ruleAction79:
+			p.Start(RAW_STRING)
+		case ruleAction80:
+			p.Emit(text)
+		case ruleAction81:
+			p.End()
+		case ruleAction82:
+			p.Start(BYTES)
+		case ruleAction83:
+			p.EmitBytes(text, begin)
+		case ruleAction84:
+			p.End()
+		case ruleAction85:
+			p.Start(NUM)
+		case ruleAction86:
+			p.Emit(text)
+		case ruleAction87:
+			p.End()
+		case ruleAction88:
+			p.Start(BOOL)
+		case ruleAction89:
+			p.Emit(text)
+		case ruleAction90:
+			p.End()
+		case ruleAction91:
+			p.Start(FUNC)
+		case ruleAction92:
+			p.End()
+		case ruleAction93:
+			p.Start(ARGS)
+		case ruleAction94:
+			p.End()
+		case ruleAction95:
+			p.Start(DEFAULT)
+		case ruleAction96:
+			p.End()
+		case ruleAction97:
+			p.Start(FUNCAPPLY)
+		case ruleAction98:
+			p.End()
+		case ruleAction99:
+			p.Start(TUPLE)
+		case ruleAction100:
+			p.End()
+		case ruleAction101:
+			p.Start(NAMED)
+		case ruleAction102:
+			p.End()
+		case ruleAction103:
+			p.Emit(text)
+		case ruleAction104:
+			p.Start(LIST)
+		case ruleAction105:
+			p.End()
+		case ruleAction106:
+			p.Start(TUPLE)
+		case ruleAction107:
+			p.End()
+		case ruleAction108:
+			p.Start(MAP)
+		case ruleAction109:
+			p.End()
 
 		}
 	}
-	_, _, _ = buffer, begin, end
+	_, _, _, _, _ = buffer, _buffer, text, begin, end
 }
 
-func (p *riftParser) Init() {
-	p.buffer = []rune(p.Buffer)
-	if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != end_symbol {
-		p.buffer = append(p.buffer, end_symbol)
+func Pretty(pretty bool) func(*riftParser) error {
+	return func(p *riftParser) error {
+		p.Pretty = pretty
+		return nil
 	}
+}
 
-	var tree tokenTree = &tokens16{tree: make([]token16, math.MaxInt16)}
-	position, depth, tokenIndex, buffer, _rules := 0, 0, 0, p.buffer, p.rules
-
-	p.Parse = func(rule ...int) error {
+func Size(size int) func(*riftParser) error {
+	return func(p *riftParser) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+func (p *riftParser) Init(options ...func(*riftParser) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		buffer               []rune
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer = p.buffer
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) error {
 		r := 1
 		if len(rule) > 0 {
 			r = rule[0]
 		}
 		matches := p.rules[r]()
-		p.tokenTree = tree
+		p.tokens32 = tree
 		if matches {
-			p.tokenTree.trim(tokenIndex)
+			p.Trim(tokenIndex)
 			return nil
 		}
-		return &parseError{p}
+		return &parseError{p, max}
 	}
 
-	p.Reset = func() {
-		position, tokenIndex, depth = 0, 0, 0
-	}
-
-	add := func(rule pegRule, begin int) {
-		if t := tree.Expand(tokenIndex); t != nil {
-			tree = t
-		}
-		tree.Add(rule, begin, position, depth, tokenIndex)
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
 		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
 	}
 
 	matchDot := func() bool {
-		if buffer[position] != end_symbol {
+		if buffer[position] != endSymbol {
 			position++
 			return true
 		}
@@ -988,34 +963,30 @@ func (p *riftParser) Init() {
 		nil,
 		/* 0 Source <- <(sp (Rift sp)+ !.)> */
 		func() bool {
-			position0, tokenIndex0, depth0 := position, tokenIndex, depth
+			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
-				depth++
 				if !_rules[rulesp]() {
 					goto l0
 				}
 				{
 					position4 := position
-					depth++
 					{
 						add(ruleAction0, position)
 					}
 					{
-						position6, tokenIndex6, depth6 := position, tokenIndex, depth
+						position6, tokenIndex6 := position, tokenIndex
 						{
 							position8 := position
-							depth++
 							if buffer[position] != rune('@') {
 								goto l6
 							}
 							position++
-							depth--
 							add(ruleGravitasse, position8)
 						}
 						goto l7
 					l6:
-						position, tokenIndex, depth = position6, tokenIndex6, depth6
+						position, tokenIndex = position6, tokenIndex6
 					}
 				l7:
 					if !_rules[ruleLocalRef]() {
@@ -1041,7 +1012,6 @@ func (p *riftParser) Init() {
 					{
 						add(ruleAction1, position)
 					}
-					depth--
 					add(ruleRift, position4)
 				}
 				if !_rules[rulesp]() {
@@ -1049,28 +1019,25 @@ func (p *riftParser) Init() {
 				}
 			l2:
 				{
-					position3, tokenIndex3, depth3 := position, tokenIndex, depth
+					position3, tokenIndex3 := position, tokenIndex
 					{
 						position10 := position
-						depth++
 						{
 							add(ruleAction0, position)
 						}
 						{
-							position12, tokenIndex12, depth12 := position, tokenIndex, depth
+							position12, tokenIndex12 := position, tokenIndex
 							{
 								position14 := position
-								depth++
 								if buffer[position] != rune('@') {
 									goto l12
 								}
 								position++
-								depth--
 								add(ruleGravitasse, position14)
 							}
 							goto l13
 						l12:
-							position, tokenIndex, depth = position12, tokenIndex12, depth12
+							position, tokenIndex = position12, tokenIndex12
 						}
 					l13:
 						if !_rules[ruleLocalRef]() {
@@ -1096,7 +1063,6 @@ func (p *riftParser) Init() {
 						{
 							add(ruleAction1, position)
 						}
-						depth--
 						add(ruleRift, position10)
 					}
 					if !_rules[rulesp]() {
@@ -1104,33 +1070,31 @@ func (p *riftParser) Init() {
 					}
 					goto l2
 				l3:
-					position, tokenIndex, depth = position3, tokenIndex3, depth3
+					position, tokenIndex = position3, tokenIndex3
 				}
 				{
-					position16, tokenIndex16, depth16 := position, tokenIndex, depth
+					position16, tokenIndex16 := position, tokenIndex
 					if !matchDot() {
 						goto l16
 					}
 					goto l0
 				l16:
-					position, tokenIndex, depth = position16, tokenIndex16, depth16
+					position, tokenIndex = position16, tokenIndex16
 				}
-				depth--
 				add(ruleSource, position1)
 			}
 			return true
 		l0:
-			position, tokenIndex, depth = position0, tokenIndex0, depth0
+			position, tokenIndex = position0, tokenIndex0
 			return false
 		},
 		/* 1 Rift <- <(Action0 Gravitasse? LocalRef sp ('=' '>') sp Block Action1)> */
 		nil,
 		/* 2 Block <- <(Action2 '{' sp (Line msp)* '}' Action3)> */
 		func() bool {
-			position18, tokenIndex18, depth18 := position, tokenIndex, depth
+			position18, tokenIndex18 := position, tokenIndex
 			{
 				position19 := position
-				depth++
 				{
 					add(ruleAction2, position)
 				}
@@ -1143,24 +1107,21 @@ func (p *riftParser) Init() {
 				}
 			l21:
 				{
-					position22, tokenIndex22, depth22 := position, tokenIndex, depth
+					position22, tokenIndex22 := position, tokenIndex
 					{
 						position23 := position
-						depth++
 						{
-							position24, tokenIndex24, depth24 := position, tokenIndex, depth
+							position24, tokenIndex24 := position, tokenIndex
 							{
 								position26 := position
-								depth++
 								{
-									position27, tokenIndex27, depth27 := position, tokenIndex, depth
+									position27, tokenIndex27 := position, tokenIndex
 									{
 										position29 := position
-										depth++
 										{
-											add(ruleAction9, position)
+											add(ruleAction16, position)
 										}
-										if !_rules[ruleLocalRef]() {
+										if !_rules[ruleTarget]() {
 											goto l28
 										}
 										if !_rules[rulesp]() {
@@ -1177,75 +1138,160 @@ func (p *riftParser) Init() {
 											goto l28
 										}
 										{
-											add(ruleAction10, position)
+											add(ruleAction17, position)
 										}
-										depth--
 										add(ruleAssignment, position29)
 									}
 									goto l27
 								l28:
-									position, tokenIndex, depth = position27, tokenIndex27, depth27
-									if !_rules[ruleIf]() {
-										goto l25
+									position, tokenIndex = position27, tokenIndex27
+									{
+										position33 := position
+										{
+											add(ruleAction24, position)
+										}
+										if buffer[position] != rune('b') {
+											goto l32
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l32
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l32
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l32
+										}
+										position++
+										if buffer[position] != rune('k') {
+											goto l32
+										}
+										position++
+										{
+											position35, tokenIndex35 := position, tokenIndex
+											if !_rules[ruleNameChar]() {
+												goto l35
+											}
+											goto l32
+										l35:
+											position, tokenIndex = position35, tokenIndex35
+										}
+										{
+											add(ruleAction25, position)
+										}
+										add(ruleBreak, position33)
+									}
+									goto l27
+								l32:
+									position, tokenIndex = position27, tokenIndex27
+									{
+										position37 := position
+										{
+											add(ruleAction26, position)
+										}
+										if buffer[position] != rune('c') {
+											goto l25
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l25
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l25
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l25
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l25
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l25
+										}
+										position++
+										if buffer[position] != rune('u') {
+											goto l25
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l25
+										}
+										position++
+										{
+											position39, tokenIndex39 := position, tokenIndex
+											if !_rules[ruleNameChar]() {
+												goto l39
+											}
+											goto l25
+										l39:
+											position, tokenIndex = position39, tokenIndex39
+										}
+										{
+											add(ruleAction27, position)
+										}
+										add(ruleContinue, position37)
 									}
 								}
 							l27:
-								depth--
 								add(ruleStatement, position26)
 							}
 							goto l24
 						l25:
-							position, tokenIndex, depth = position24, tokenIndex24, depth24
+							position, tokenIndex = position24, tokenIndex24
 							if !_rules[ruleExpr]() {
 								goto l22
 							}
 						}
 					l24:
-						depth--
 						add(ruleLine, position23)
 					}
 					{
-						position32 := position
-						depth++
+						position41 := position
 						{
-							position35, tokenIndex35, depth35 := position, tokenIndex, depth
+							position44, tokenIndex44 := position, tokenIndex
 							if !_rules[rulews]() {
-								goto l36
+								goto l45
 							}
-							goto l35
-						l36:
-							position, tokenIndex, depth = position35, tokenIndex35, depth35
+							goto l44
+						l45:
+							position, tokenIndex = position44, tokenIndex44
 							if !_rules[rulecomment]() {
 								goto l22
 							}
 						}
-					l35:
-					l33:
+					l44:
+					l42:
 						{
-							position34, tokenIndex34, depth34 := position, tokenIndex, depth
+							position43, tokenIndex43 := position, tokenIndex
 							{
-								position37, tokenIndex37, depth37 := position, tokenIndex, depth
+								position46, tokenIndex46 := position, tokenIndex
 								if !_rules[rulews]() {
-									goto l38
+									goto l47
 								}
-								goto l37
-							l38:
-								position, tokenIndex, depth = position37, tokenIndex37, depth37
+								goto l46
+							l47:
+								position, tokenIndex = position46, tokenIndex46
 								if !_rules[rulecomment]() {
-									goto l34
+									goto l43
 								}
 							}
-						l37:
-							goto l33
-						l34:
-							position, tokenIndex, depth = position34, tokenIndex34, depth34
+						l46:
+							goto l42
+						l43:
+							position, tokenIndex = position43, tokenIndex43
 						}
-						depth--
-						add(rulemsp, position32)
+						add(rulemsp, position41)
 					}
 					goto l21
 				l22:
-					position, tokenIndex, depth = position22, tokenIndex22, depth22
+					position, tokenIndex = position22, tokenIndex22
 				}
 				if buffer[position] != rune('}') {
 					goto l18
@@ -1254,1628 +1300,4388 @@ func (p *riftParser) Init() {
 				{
 					add(ruleAction3, position)
 				}
-				depth--
 				add(ruleBlock, position19)
 			}
 			return true
 		l18:
-			position, tokenIndex, depth = position18, tokenIndex18, depth18
+			position, tokenIndex = position18, tokenIndex18
 			return false
 		},
 		/* 3 Line <- <(Statement / Expr)> */
 		nil,
 		/* 4 Expr <- <((!Op Single) / Op)> */
 		func() bool {
-			position41, tokenIndex41, depth41 := position, tokenIndex, depth
+			position50, tokenIndex50 := position, tokenIndex
 			{
-				position42 := position
-				depth++
+				position51 := position
 				{
-					position43, tokenIndex43, depth43 := position, tokenIndex, depth
+					position52, tokenIndex52 := position, tokenIndex
 					{
-						position45, tokenIndex45, depth45 := position, tokenIndex, depth
+						position54, tokenIndex54 := position, tokenIndex
 						if !_rules[ruleOp]() {
-							goto l45
+							goto l54
 						}
-						goto l44
-					l45:
-						position, tokenIndex, depth = position45, tokenIndex45, depth45
+						goto l53
+					l54:
+						position, tokenIndex = position54, tokenIndex54
 					}
 					if !_rules[ruleSingle]() {
-						goto l44
+						goto l53
 					}
-					goto l43
-				l44:
-					position, tokenIndex, depth = position43, tokenIndex43, depth43
+					goto l52
+				l53:
+					position, tokenIndex = position52, tokenIndex52
 					if !_rules[ruleOp]() {
-						goto l41
+						goto l50
 					}
 				}
-			l43:
-				depth--
-				add(ruleExpr, position42)
+			l52:
+				add(ruleExpr, position51)
 			}
 			return true
-		l41:
-			position, tokenIndex, depth = position41, tokenIndex41, depth41
+		l50:
+			position, tokenIndex = position50, tokenIndex50
 			return false
 		},
-		/* 5 Single <- <(If / FuncApply / Value)> */
+		/* 5 Single <- <((If / Match / For / While / Try / Record / FuncApply / Value) Postfix*)> */
 		func() bool {
-			position46, tokenIndex46, depth46 := position, tokenIndex, depth
+			position55, tokenIndex55 := position, tokenIndex
 			{
-				position47 := position
-				depth++
+				position56 := position
 				{
-					position48, tokenIndex48, depth48 := position, tokenIndex, depth
-					if !_rules[ruleIf]() {
-						goto l49
+					position57, tokenIndex57 := position, tokenIndex
+					{
+						position59 := position
+						{
+							add(ruleAction18, position)
+						}
+						if buffer[position] != rune('i') {
+							goto l58
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l58
+						}
+						position++
+						{
+							position61, tokenIndex61 := position, tokenIndex
+							if !_rules[ruleNameChar]() {
+								goto l61
+							}
+							goto l58
+						l61:
+							position, tokenIndex = position61, tokenIndex61
+						}
+						if !_rules[rulesp]() {
+							goto l58
+						}
+						if !_rules[ruleExpr]() {
+							goto l58
+						}
+						if !_rules[rulesp]() {
+							goto l58
+						}
+						if !_rules[ruleBlock]() {
+							goto l58
+						}
+					l62:
+						{
+							position63, tokenIndex63 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l63
+							}
+							{
+								position64 := position
+								if buffer[position] != rune('e') {
+									goto l63
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l63
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l63
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l63
+								}
+								position++
+								{
+									position65, tokenIndex65 := position, tokenIndex
+									if !_rules[ruleNameChar]() {
+										goto l65
+									}
+									goto l63
+								l65:
+									position, tokenIndex = position65, tokenIndex65
+								}
+								if !_rules[rulesp]() {
+									goto l63
+								}
+								if buffer[position] != rune('i') {
+									goto l63
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l63
+								}
+								position++
+								{
+									position66, tokenIndex66 := position, tokenIndex
+									if !_rules[ruleNameChar]() {
+										goto l66
+									}
+									goto l63
+								l66:
+									position, tokenIndex = position66, tokenIndex66
+								}
+								if !_rules[rulesp]() {
+									goto l63
+								}
+								if !_rules[ruleExpr]() {
+									goto l63
+								}
+								if !_rules[rulesp]() {
+									goto l63
+								}
+								if !_rules[ruleBlock]() {
+									goto l63
+								}
+								add(ruleElseIf, position64)
+							}
+							goto l62
+						l63:
+							position, tokenIndex = position63, tokenIndex63
+						}
+						{
+							position67, tokenIndex67 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l67
+							}
+							if buffer[position] != rune('e') {
+								goto l67
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l67
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l67
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l67
+							}
+							position++
+							{
+								position69, tokenIndex69 := position, tokenIndex
+								if !_rules[ruleNameChar]() {
+									goto l69
+								}
+								goto l67
+							l69:
+								position, tokenIndex = position69, tokenIndex69
+							}
+							if !_rules[rulesp]() {
+								goto l67
+							}
+							if !_rules[ruleBlock]() {
+								goto l67
+							}
+							goto l68
+						l67:
+							position, tokenIndex = position67, tokenIndex67
+						}
+					l68:
+						{
+							add(ruleAction19, position)
+						}
+						add(ruleIf, position59)
 					}
-					goto l48
-				l49:
-					position, tokenIndex, depth = position48, tokenIndex48, depth48
+					goto l57
+				l58:
+					position, tokenIndex = position57, tokenIndex57
 					{
-						position51 := position
-						depth++
+						position72 := position
 						{
 							add(ruleAction34, position)
 						}
-						if !_rules[ruleRef]() {
-							goto l50
-						}
-						if !_rules[ruleTuple]() {
-							goto l50
+						{
+							position74 := position
+							if buffer[position] != rune('m') {
+								goto l71
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l71
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l71
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l71
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l71
+							}
+							position++
+							add(rulePegText, position74)
 						}
 						{
 							add(ruleAction35, position)
 						}
-						depth--
-						add(ruleFuncApply, position51)
+						{
+							position76, tokenIndex76 := position, tokenIndex
+							if !_rules[ruleNameChar]() {
+								goto l76
+							}
+							goto l71
+						l76:
+							position, tokenIndex = position76, tokenIndex76
+						}
+						if !_rules[rulesp]() {
+							goto l71
+						}
+						if !_rules[ruleExpr]() {
+							goto l71
+						}
+						if !_rules[rulesp]() {
+							goto l71
+						}
+						if buffer[position] != rune('{') {
+							goto l71
+						}
+						position++
+						if !_rules[rulesp]() {
+							goto l71
+						}
+						if !_rules[ruleCase]() {
+							goto l71
+						}
+					l77:
+						{
+							position78, tokenIndex78 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l78
+							}
+							if buffer[position] != rune(',') {
+								goto l78
+							}
+							position++
+							if !_rules[rulesp]() {
+								goto l78
+							}
+							if !_rules[ruleCase]() {
+								goto l78
+							}
+							goto l77
+						l78:
+							position, tokenIndex = position78, tokenIndex78
+						}
+						{
+							position79, tokenIndex79 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l79
+							}
+							if buffer[position] != rune(',') {
+								goto l79
+							}
+							position++
+							goto l80
+						l79:
+							position, tokenIndex = position79, tokenIndex79
+						}
+					l80:
+						if !_rules[rulesp]() {
+							goto l71
+						}
+						if buffer[position] != rune('}') {
+							goto l71
+						}
+						position++
+						{
+							add(ruleAction36, position)
+						}
+						{
+							add(ruleAction37, position)
+						}
+						add(ruleMatch, position72)
+					}
+					goto l57
+				l71:
+					position, tokenIndex = position57, tokenIndex57
+					{
+						position84 := position
+						{
+							add(ruleAction20, position)
+						}
+						if buffer[position] != rune('f') {
+							goto l83
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l83
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l83
+						}
+						position++
+						{
+							position86, tokenIndex86 := position, tokenIndex
+							if !_rules[ruleNameChar]() {
+								goto l86
+							}
+							goto l83
+						l86:
+							position, tokenIndex = position86, tokenIndex86
+						}
+						if !_rules[rulesp]() {
+							goto l83
+						}
+						if !_rules[ruleTarget]() {
+							goto l83
+						}
+						if !_rules[rulesp]() {
+							goto l83
+						}
+						if buffer[position] != rune('i') {
+							goto l83
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l83
+						}
+						position++
+						{
+							position87, tokenIndex87 := position, tokenIndex
+							if !_rules[ruleNameChar]() {
+								goto l87
+							}
+							goto l83
+						l87:
+							position, tokenIndex = position87, tokenIndex87
+						}
+						if !_rules[rulesp]() {
+							goto l83
+						}
+						if !_rules[ruleExpr]() {
+							goto l83
+						}
+						if !_rules[rulesp]() {
+							goto l83
+						}
+						if !_rules[ruleBlock]() {
+							goto l83
+						}
+						{
+							add(ruleAction21, position)
+						}
+						add(ruleFor, position84)
+					}
+					goto l57
+				l83:
+					position, tokenIndex = position57, tokenIndex57
+					{
+						position90 := position
+						{
+							add(ruleAction22, position)
+						}
+						if buffer[position] != rune('w') {
+							goto l89
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l89
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l89
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l89
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l89
+						}
+						position++
+						{
+							position92, tokenIndex92 := position, tokenIndex
+							if !_rules[ruleNameChar]() {
+								goto l92
+							}
+							goto l89
+						l92:
+							position, tokenIndex = position92, tokenIndex92
+						}
+						if !_rules[rulesp]() {
+							goto l89
+						}
+						if !_rules[ruleExpr]() {
+							goto l89
+						}
+						if !_rules[rulesp]() {
+							goto l89
+						}
+						if !_rules[ruleBlock]() {
+							goto l89
+						}
+						{
+							add(ruleAction23, position)
+						}
+						add(ruleWhile, position90)
 					}
-					goto l48
-				l50:
-					position, tokenIndex, depth = position48, tokenIndex48, depth48
+					goto l57
+				l89:
+					position, tokenIndex = position57, tokenIndex57
 					{
-						position54 := position
-						depth++
+						position95 := position
+						{
+							add(ruleAction28, position)
+						}
+						if buffer[position] != rune('t') {
+							goto l94
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l94
+						}
+						position++
+						if buffer[position] != rune('y') {
+							goto l94
+						}
+						position++
+						{
+							position97, tokenIndex97 := position, tokenIndex
+							if !_rules[ruleNameChar]() {
+								goto l97
+							}
+							goto l94
+						l97:
+							position, tokenIndex = position97, tokenIndex97
+						}
+						if !_rules[rulesp]() {
+							goto l94
+						}
+						if !_rules[ruleBlock]() {
+							goto l94
+						}
 						{
-							position55, tokenIndex55, depth55 := position, tokenIndex, depth
+							position98, tokenIndex98 := position, tokenIndex
+							{
+								position100, tokenIndex100 := position, tokenIndex
+								if !_rules[rulesp]() {
+									goto l100
+								}
+								if !_rules[ruleCatch]() {
+									goto l100
+								}
+								goto l101
+							l100:
+								position, tokenIndex = position100, tokenIndex100
+							}
+						l101:
+							if !_rules[rulesp]() {
+								goto l99
+							}
 							{
-								position57 := position
-								depth++
+								position102 := position
 								{
-									position58, tokenIndex58, depth58 := position, tokenIndex, depth
-									{
-										position60 := position
-										depth++
-										{
-											add(ruleAction30, position)
-										}
-										{
-											position62 := position
-											depth++
-											{
-												add(ruleAction32, position)
-											}
+									add(ruleAction32, position)
+								}
+								if buffer[position] != rune('f') {
+									goto l99
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l99
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l99
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l99
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l99
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l99
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l99
+								}
+								position++
+								{
+									position104, tokenIndex104 := position, tokenIndex
+									if !_rules[ruleNameChar]() {
+										goto l104
+									}
+									goto l99
+								l104:
+									position, tokenIndex = position104, tokenIndex104
+								}
+								if !_rules[rulesp]() {
+									goto l99
+								}
+								if !_rules[ruleBlock]() {
+									goto l99
+								}
+								{
+									add(ruleAction33, position)
+								}
+								add(ruleFinally, position102)
+							}
+							goto l98
+						l99:
+							position, tokenIndex = position98, tokenIndex98
+							if !_rules[rulesp]() {
+								goto l94
+							}
+							if !_rules[ruleCatch]() {
+								goto l94
+							}
+						}
+					l98:
+						{
+							add(ruleAction29, position)
+						}
+						add(ruleTry, position95)
+					}
+					goto l57
+				l94:
+					position, tokenIndex = position57, tokenIndex57
+					{
+						position108 := position
+						{
+							add(ruleAction8, position)
+						}
+						if buffer[position] != rune('r') {
+							goto l107
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l107
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l107
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l107
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l107
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l107
+						}
+						position++
+						{
+							position110, tokenIndex110 := position, tokenIndex
+							if !_rules[ruleNameChar]() {
+								goto l110
+							}
+							goto l107
+						l110:
+							position, tokenIndex = position110, tokenIndex110
+						}
+						if !_rules[rulesp]() {
+							goto l107
+						}
+						if buffer[position] != rune('(') {
+							goto l107
+						}
+						position++
+						if !_rules[rulesp]() {
+							goto l107
+						}
+						if !_rules[ruleField]() {
+							goto l107
+						}
+					l111:
+						{
+							position112, tokenIndex112 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l112
+							}
+							if buffer[position] != rune(',') {
+								goto l112
+							}
+							position++
+							if !_rules[rulesp]() {
+								goto l112
+							}
+							if !_rules[ruleField]() {
+								goto l112
+							}
+							goto l111
+						l112:
+							position, tokenIndex = position112, tokenIndex112
+						}
+						if !_rules[rulesp]() {
+							goto l107
+						}
+						if buffer[position] != rune(')') {
+							goto l107
+						}
+						position++
+						{
+							add(ruleAction9, position)
+						}
+						add(ruleRecord, position108)
+					}
+					goto l57
+				l107:
+					position, tokenIndex = position57, tokenIndex57
+					{
+						position115 := position
+						{
+							add(ruleAction97, position)
+						}
+						if !_rules[ruleRef]() {
+							goto l114
+						}
+						{
+							position117 := position
+							{
+								add(ruleAction99, position)
+							}
+							if buffer[position] != rune('(') {
+								goto l114
+							}
+							position++
+							if !_rules[rulesp]() {
+								goto l114
+							}
+							{
+								position119, tokenIndex119 := position, tokenIndex
+								{
+									position121 := position
+									{
+										position122, tokenIndex122 := position, tokenIndex
+										if !_rules[ruleNamedArgs]() {
+											goto l123
+										}
+										goto l122
+									l123:
+										position, tokenIndex = position122, tokenIndex122
+										if !_rules[rulePosArg]() {
+											goto l119
+										}
+									l124:
+										{
+											position125, tokenIndex125 := position, tokenIndex
+											if !_rules[rulesp]() {
+												goto l125
+											}
+											if buffer[position] != rune(',') {
+												goto l125
+											}
+											position++
+											if !_rules[rulesp]() {
+												goto l125
+											}
+											if !_rules[rulePosArg]() {
+												goto l125
+											}
+											goto l124
+										l125:
+											position, tokenIndex = position125, tokenIndex125
+										}
+										{
+											position126, tokenIndex126 := position, tokenIndex
+											if !_rules[rulesp]() {
+												goto l126
+											}
+											if buffer[position] != rune(',') {
+												goto l126
+											}
+											position++
+											if !_rules[rulesp]() {
+												goto l126
+											}
+											if !_rules[ruleNamedArgs]() {
+												goto l126
+											}
+											goto l127
+										l126:
+											position, tokenIndex = position126, tokenIndex126
+										}
+									l127:
+									}
+								l122:
+									add(ruleCallArgList, position121)
+								}
+								if !_rules[rulesp]() {
+									goto l119
+								}
+								goto l120
+							l119:
+								position, tokenIndex = position119, tokenIndex119
+							}
+						l120:
+							if buffer[position] != rune(')') {
+								goto l114
+							}
+							position++
+							{
+								add(ruleAction100, position)
+							}
+							add(ruleCallArgs, position117)
+						}
+						{
+							add(ruleAction98, position)
+						}
+						add(ruleFuncApply, position115)
+					}
+					goto l57
+				l114:
+					position, tokenIndex = position57, tokenIndex57
+					{
+						position130 := position
+						{
+							position131, tokenIndex131 := position, tokenIndex
+							{
+								position133 := position
+								{
+									position134, tokenIndex134 := position, tokenIndex
+									{
+										position136 := position
+										{
+											add(ruleAction91, position)
+										}
+										{
+											position138 := position
+											{
+												add(ruleAction93, position)
+											}
 											if buffer[position] != rune('(') {
-												goto l59
+												goto l135
 											}
 											position++
 											if !_rules[rulesp]() {
-												goto l59
+												goto l135
 											}
 											{
-												position64, tokenIndex64, depth64 := position, tokenIndex, depth
-												if !_rules[ruleLocalRef]() {
-													goto l64
-												}
-											l66:
+												position140, tokenIndex140 := position, tokenIndex
 												{
-													position67, tokenIndex67, depth67 := position, tokenIndex, depth
-													if !_rules[rulesp]() {
-														goto l67
-													}
-													if buffer[position] != rune(',') {
-														goto l67
-													}
-													position++
-													if !_rules[rulesp]() {
-														goto l67
-													}
-													if !_rules[ruleLocalRef]() {
-														goto l67
+													position142 := position
+													{
+														position143, tokenIndex143 := position, tokenIndex
+														if !_rules[ruleRestPat]() {
+															goto l144
+														}
+														goto l143
+													l144:
+														position, tokenIndex = position143, tokenIndex143
+														if !_rules[ruleParam]() {
+															goto l140
+														}
+													l145:
+														{
+															position146, tokenIndex146 := position, tokenIndex
+															if !_rules[rulesp]() {
+																goto l146
+															}
+															if buffer[position] != rune(',') {
+																goto l146
+															}
+															position++
+															if !_rules[rulesp]() {
+																goto l146
+															}
+															if !_rules[ruleParam]() {
+																goto l146
+															}
+															goto l145
+														l146:
+															position, tokenIndex = position146, tokenIndex146
+														}
+														{
+															position147, tokenIndex147 := position, tokenIndex
+															if !_rules[rulesp]() {
+																goto l147
+															}
+															if buffer[position] != rune(',') {
+																goto l147
+															}
+															position++
+															if !_rules[rulesp]() {
+																goto l147
+															}
+															if !_rules[ruleRestPat]() {
+																goto l147
+															}
+															goto l148
+														l147:
+															position, tokenIndex = position147, tokenIndex147
+														}
+													l148:
 													}
-													goto l66
-												l67:
-													position, tokenIndex, depth = position67, tokenIndex67, depth67
+												l143:
+													add(ruleParams, position142)
 												}
 												if !_rules[rulesp]() {
-													goto l64
+													goto l140
 												}
-												goto l65
-											l64:
-												position, tokenIndex, depth = position64, tokenIndex64, depth64
+												goto l141
+											l140:
+												position, tokenIndex = position140, tokenIndex140
 											}
-										l65:
+										l141:
 											if buffer[position] != rune(')') {
-												goto l59
+												goto l135
 											}
 											position++
 											{
-												add(ruleAction33, position)
+												add(ruleAction94, position)
 											}
-											depth--
-											add(ruleFuncArgs, position62)
+											add(ruleFuncArgs, position138)
 										}
 										if !_rules[rulesp]() {
-											goto l59
+											goto l135
 										}
 										if buffer[position] != rune('-') {
-											goto l59
+											goto l135
 										}
 										position++
 										if buffer[position] != rune('>') {
-											goto l59
+											goto l135
 										}
 										position++
 										if !_rules[rulesp]() {
-											goto l59
+											goto l135
 										}
 										{
-											position69, tokenIndex69, depth69 := position, tokenIndex, depth
+											position150, tokenIndex150 := position, tokenIndex
 											if !_rules[ruleBlock]() {
-												goto l70
+												goto l151
 											}
-											goto l69
-										l70:
-											position, tokenIndex, depth = position69, tokenIndex69, depth69
+											goto l150
+										l151:
+											position, tokenIndex = position150, tokenIndex150
 											if !_rules[ruleExpr]() {
-												goto l59
+												goto l135
 											}
 										}
-									l69:
+									l150:
 										{
-											add(ruleAction31, position)
+											add(ruleAction92, position)
 										}
-										depth--
-										add(ruleFunc, position60)
+										add(ruleFunc, position136)
 									}
-									goto l58
-								l59:
-									position, tokenIndex, depth = position58, tokenIndex58, depth58
+									goto l134
+								l135:
+									position, tokenIndex = position134, tokenIndex134
 									{
-										position73 := position
-										depth++
+										position154 := position
 										{
 											switch buffer[position] {
 											case 'f', 't':
-												{
-													position75 := position
-													depth++
-													{
-														add(ruleAction27, position)
-													}
-													{
-														position77 := position
-														depth++
-														{
-															position78, tokenIndex78, depth78 := position, tokenIndex, depth
-															if buffer[position] != rune('t') {
-																goto l79
-															}
-															position++
-															if buffer[position] != rune('r') {
-																goto l79
-															}
-															position++
-															if buffer[position] != rune('u') {
-																goto l79
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l79
-															}
-															position++
-															goto l78
-														l79:
-															position, tokenIndex, depth = position78, tokenIndex78, depth78
-															if buffer[position] != rune('f') {
-																goto l72
-															}
-															position++
-															if buffer[position] != rune('a') {
-																goto l72
-															}
-															position++
-															if buffer[position] != rune('l') {
-																goto l72
-															}
-															position++
-															if buffer[position] != rune('s') {
-																goto l72
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l72
-															}
-															position++
-														}
-													l78:
-														depth--
-														add(rulePegText, position77)
-													}
-													{
-														add(ruleAction28, position)
-													}
-													{
-														add(ruleAction29, position)
-													}
-													depth--
-													add(ruleBoolean, position75)
+												if !_rules[ruleBoolean]() {
+													goto l153
+												}
+											case 'b':
+												if !_rules[ruleBytes]() {
+													goto l153
 												}
-												break
-											case '"':
+											case '"', '`':
 												{
-													position82 := position
-													depth++
-													{
-														add(ruleAction20, position)
-													}
-													if buffer[position] != rune('"') {
-														goto l72
-													}
-													position++
+													position156 := position
 													{
-														position84 := position
-														depth++
-													l85:
+														position157, tokenIndex157 := position, tokenIndex
+														if !_rules[ruleRawString]() {
+															goto l158
+														}
+														goto l157
+													l158:
+														position, tokenIndex = position157, tokenIndex157
+														if !_rules[ruleTextBlock]() {
+															goto l159
+														}
+														goto l157
+													l159:
+														position, tokenIndex = position157, tokenIndex157
 														{
-															position86, tokenIndex86, depth86 := position, tokenIndex, depth
+															position160 := position
 															{
-																position87 := position
-																depth++
+																position161, tokenIndex161 := position, tokenIndex
+																if !_rules[rulePlainStr]() {
+																	goto l162
+																}
+																goto l161
+															l162:
+																position, tokenIndex = position161, tokenIndex161
 																{
-																	position88, tokenIndex88, depth88 := position, tokenIndex, depth
+																	position163 := position
+																	{
+																		add(ruleAction67, position)
+																	}
+																	if buffer[position] != rune('"') {
+																		goto l153
+																	}
+																	position++
+																l165:
 																	{
-																		position90 := position
-																		depth++
+																		position166, tokenIndex166 := position, tokenIndex
 																		{
-																			position91 := position
-																			depth++
-																			if buffer[position] != rune('\\') {
-																				goto l89
-																			}
-																			position++
+																			position167, tokenIndex167 := position, tokenIndex
 																			{
-																				switch buffer[position] {
-																				case 'v':
-																					if buffer[position] != rune('v') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case 't':
-																					if buffer[position] != rune('t') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case 'r':
-																					if buffer[position] != rune('r') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case 'n':
-																					if buffer[position] != rune('n') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case 'f':
-																					if buffer[position] != rune('f') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case 'b':
-																					if buffer[position] != rune('b') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case 'a':
-																					if buffer[position] != rune('a') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case '\\':
-																					if buffer[position] != rune('\\') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case '?':
-																					if buffer[position] != rune('?') {
-																						goto l89
-																					}
-																					position++
-																					break
-																				case '"':
-																					if buffer[position] != rune('"') {
-																						goto l89
+																				position169 := position
+																				{
+																					add(ruleAction69, position)
+																				}
+																				{
+																					position171 := position
+																					if !_rules[ruleStringChar]() {
+																						goto l168
 																					}
-																					position++
-																					break
-																				default:
-																					if buffer[position] != rune('\'') {
-																						goto l89
+																				l172:
+																					{
+																						position173, tokenIndex173 := position, tokenIndex
+																						if !_rules[ruleStringChar]() {
+																							goto l173
+																						}
+																						goto l172
+																					l173:
+																						position, tokenIndex = position173, tokenIndex173
 																					}
-																					position++
-																					break
+																					add(rulePegText, position171)
 																				}
-																			}
-
-																			depth--
-																			add(ruleSimpleEsc, position91)
-																		}
-																		depth--
-																		add(ruleStringEsc, position90)
-																	}
-																	goto l88
-																l89:
-																	position, tokenIndex, depth = position88, tokenIndex88, depth88
-																	{
-																		position93, tokenIndex93, depth93 := position, tokenIndex, depth
-																		{
-																			switch buffer[position] {
-																			case '\\':
-																				if buffer[position] != rune('\\') {
-																					goto l93
+																				{
+																					add(ruleAction70, position)
 																				}
-																				position++
-																				break
-																			case '\n':
-																				if buffer[position] != rune('\n') {
-																					goto l93
+																				{
+																					add(ruleAction71, position)
 																				}
-																				position++
-																				break
-																			default:
-																				if buffer[position] != rune('"') {
-																					goto l93
+																				add(ruleStrPart, position169)
+																			}
+																			goto l167
+																		l168:
+																			position, tokenIndex = position167, tokenIndex167
+																			{
+																				position176 := position
+																				{
+																					position177, tokenIndex177 := position, tokenIndex
+																					{
+																						position179 := position
+																						if buffer[position] != rune('$') {
+																							goto l178
+																						}
+																						position++
+																						if buffer[position] != rune('{') {
+																							goto l178
+																						}
+																						position++
+																						p.interpolating++
+																						{
+																							position180, tokenIndex180 := position, tokenIndex
+																							{
+																								position182 := position
+																								{
+																									add(ruleAction72, position)
+																								}
+																								if !_rules[rulesp]() {
+																									goto l181
+																								}
+																								if !_rules[ruleExpr]() {
+																									goto l181
+																								}
+																								if !_rules[rulesp]() {
+																									goto l181
+																								}
+																								{
+																									position184, tokenIndex184 := position, tokenIndex
+																									if buffer[position] != rune(':') {
+																										goto l184
+																									}
+																									position++
+																									{
+																										position186 := position
+																										{
+																											position187 := position
+																										l188:
+																											{
+																												position189, tokenIndex189 := position, tokenIndex
+																												{
+																													switch buffer[position] {
+																													case '0':
+																														if buffer[position] != rune('0') {
+																															goto l189
+																														}
+																														position++
+																													case '#':
+																														if buffer[position] != rune('#') {
+																															goto l189
+																														}
+																														position++
+																													case ' ':
+																														if buffer[position] != rune(' ') {
+																															goto l189
+																														}
+																														position++
+																													case '+':
+																														if buffer[position] != rune('+') {
+																															goto l189
+																														}
+																														position++
+																													default:
+																														if buffer[position] != rune('-') {
+																															goto l189
+																														}
+																														position++
+																													}
+																												}
+
+																												goto l188
+																											l189:
+																												position, tokenIndex = position189, tokenIndex189
+																											}
+																										l191:
+																											{
+																												position192, tokenIndex192 := position, tokenIndex
+																												if !_rules[ruleDigit]() {
+																													goto l192
+																												}
+																												goto l191
+																											l192:
+																												position, tokenIndex = position192, tokenIndex192
+																											}
+																											{
+																												position193, tokenIndex193 := position, tokenIndex
+																												if buffer[position] != rune('.') {
+																													goto l193
+																												}
+																												position++
+																												if !_rules[ruleDigit]() {
+																													goto l193
+																												}
+																											l195:
+																												{
+																													position196, tokenIndex196 := position, tokenIndex
+																													if !_rules[ruleDigit]() {
+																														goto l196
+																													}
+																													goto l195
+																												l196:
+																													position, tokenIndex = position196, tokenIndex196
+																												}
+																												goto l194
+																											l193:
+																												position, tokenIndex = position193, tokenIndex193
+																											}
+																										l194:
+																											{
+																												position197, tokenIndex197 := position, tokenIndex
+																												{
+																													position199, tokenIndex199 := position, tokenIndex
+																													if c := buffer[position]; c < rune('a') || c > rune('z') {
+																														goto l200
+																													}
+																													position++
+																													goto l199
+																												l200:
+																													position, tokenIndex = position199, tokenIndex199
+																													if c := buffer[position]; c < rune('A') || c > rune('Z') {
+																														goto l197
+																													}
+																													position++
+																												}
+																											l199:
+																												goto l198
+																											l197:
+																												position, tokenIndex = position197, tokenIndex197
+																											}
+																										l198:
+																											add(ruleFormatSpec, position187)
+																										}
+																										add(rulePegText, position186)
+																									}
+																									{
+																										add(ruleAction73, position)
+																									}
+																									goto l185
+																								l184:
+																									position, tokenIndex = position184, tokenIndex184
+																								}
+																							l185:
+																								if buffer[position] != rune('}') {
+																									goto l181
+																								}
+																								position++
+																								{
+																									add(ruleAction74, position)
+																								}
+																								add(ruleInterpBody, position182)
+																							}
+																							p.interpolating--
+																							goto l180
+																						l181:
+																							position, tokenIndex = position180, tokenIndex180
+																							p.interpolating--
+																							if !(false) {
+																								goto l178
+																							}
+																						}
+																					l180:
+																						add(ruleGoodInterp, position179)
+																					}
+																					goto l177
+																				l178:
+																					position, tokenIndex = position177, tokenIndex177
+																					{
+																						position203 := position
+																						if buffer[position] != rune('$') {
+																							goto l166
+																						}
+																						position++
+																						if buffer[position] != rune('{') {
+																							goto l166
+																						}
+																						position++
+																						{
+																							position204 := position
+																						l205:
+																							{
+																								position206, tokenIndex206 := position, tokenIndex
+																								{
+																									position207, tokenIndex207 := position, tokenIndex
+																									if buffer[position] != rune('}') {
+																										goto l207
+																									}
+																									position++
+																									goto l206
+																								l207:
+																									position, tokenIndex = position207, tokenIndex207
+																								}
+																								{
+																									position208, tokenIndex208 := position, tokenIndex
+																									if buffer[position] != rune('"') {
+																										goto l208
+																									}
+																									position++
+																									goto l206
+																								l208:
+																									position, tokenIndex = position208, tokenIndex208
+																								}
+																								{
+																									position209, tokenIndex209 := position, tokenIndex
+																									if buffer[position] != rune('\n') {
+																										goto l209
+																									}
+																									position++
+																									goto l206
+																								l209:
+																									position, tokenIndex = position209, tokenIndex209
+																								}
+																								if !matchDot() {
+																									goto l206
+																								}
+																								goto l205
+																							l206:
+																								position, tokenIndex = position206, tokenIndex206
+																							}
+																							add(rulePegText, position204)
+																						}
+																						{
+																							position210, tokenIndex210 := position, tokenIndex
+																							if buffer[position] != rune('}') {
+																								goto l210
+																							}
+																							position++
+																							goto l211
+																						l210:
+																							position, tokenIndex = position210, tokenIndex210
+																						}
+																					l211:
+																						{
+																							add(ruleAction75, position)
+																						}
+																						add(ruleBadInterp, position203)
+																					}
 																				}
-																				position++
-																				break
+																			l177:
+																				add(ruleInterp, position176)
 																			}
 																		}
-
-																		goto l86
-																	l93:
-																		position, tokenIndex, depth = position93, tokenIndex93, depth93
+																	l167:
+																		goto l165
+																	l166:
+																		position, tokenIndex = position166, tokenIndex166
+																	}
+																	if buffer[position] != rune('"') {
+																		goto l153
 																	}
-																	if !matchDot() {
-																		goto l86
+																	position++
+																	{
+																		add(ruleAction68, position)
 																	}
+																	add(ruleInterpolated, position163)
 																}
-															l88:
-																depth--
-																add(ruleStringChar, position87)
 															}
-															goto l85
-														l86:
-															position, tokenIndex, depth = position86, tokenIndex86, depth86
+														l161:
+															add(ruleQuotedStr, position160)
 														}
-														depth--
-														add(rulePegText, position84)
-													}
-													if buffer[position] != rune('"') {
-														goto l72
-													}
-													position++
-													{
-														add(ruleAction21, position)
-													}
-													{
-														add(ruleAction22, position)
 													}
-													depth--
-													add(ruleString, position82)
+												l157:
+													add(ruleString, position156)
 												}
-												break
 											default:
-												{
-													position97 := position
-													depth++
-													{
-														add(ruleAction23, position)
-													}
-													{
-														position99, tokenIndex99, depth99 := position, tokenIndex, depth
-														{
-															position101 := position
-															depth++
-															if !_rules[ruleDecimal]() {
-																goto l100
-															}
-															{
-																position102, tokenIndex102, depth102 := position, tokenIndex, depth
-																if buffer[position] != rune('e') {
-																	goto l103
-																}
-																position++
-																goto l102
-															l103:
-																position, tokenIndex, depth = position102, tokenIndex102, depth102
-																if buffer[position] != rune('E') {
-																	goto l100
-																}
-																position++
-															}
-														l102:
-															if !_rules[ruleInteger]() {
-																goto l100
-															}
-															depth--
-															add(ruleSciNum, position101)
-														}
-														goto l99
-													l100:
-														position, tokenIndex, depth = position99, tokenIndex99, depth99
-														if !_rules[ruleDecimal]() {
-															goto l104
-														}
-														goto l99
-													l104:
-														position, tokenIndex, depth = position99, tokenIndex99, depth99
-														if !_rules[ruleInteger]() {
-															goto l72
-														}
-													}
-												l99:
-													{
-														add(ruleAction24, position)
-													}
-													depth--
-													add(ruleNumeric, position97)
+												if !_rules[ruleNumeric]() {
+													goto l153
 												}
-												break
 											}
 										}
 
-										depth--
-										add(ruleScalar, position73)
+										add(ruleScalar, position154)
 									}
-									goto l58
-								l72:
-									position, tokenIndex, depth = position58, tokenIndex58, depth58
+									goto l134
+								l153:
+									position, tokenIndex = position134, tokenIndex134
 									{
-										position106 := position
-										depth++
+										position214 := position
 										{
 											switch buffer[position] {
 											case '{':
 												{
-													position108 := position
-													depth++
+													position216 := position
 													{
-														add(ruleAction40, position)
+														add(ruleAction108, position)
 													}
 													if buffer[position] != rune('{') {
-														goto l56
+														goto l132
 													}
 													position++
 													if !_rules[rulesp]() {
-														goto l56
+														goto l132
 													}
 													{
-														position110, tokenIndex110, depth110 := position, tokenIndex, depth
+														position218, tokenIndex218 := position, tokenIndex
 														if !_rules[ruleExpr]() {
-															goto l110
+															goto l218
 														}
 														if !_rules[rulesp]() {
-															goto l110
+															goto l218
 														}
 														if buffer[position] != rune(':') {
-															goto l110
+															goto l218
 														}
 														position++
 														if !_rules[rulesp]() {
-															goto l110
+															goto l218
 														}
 														if !_rules[ruleExpr]() {
-															goto l110
+															goto l218
 														}
-													l112:
+													l220:
 														{
-															position113, tokenIndex113, depth113 := position, tokenIndex, depth
+															position221, tokenIndex221 := position, tokenIndex
 															if !_rules[rulesp]() {
-																goto l113
+																goto l221
 															}
 															if buffer[position] != rune(',') {
-																goto l113
+																goto l221
 															}
 															position++
 															if !_rules[rulesp]() {
-																goto l113
+																goto l221
 															}
 															if !_rules[ruleExpr]() {
-																goto l113
+																goto l221
 															}
 															if !_rules[rulesp]() {
-																goto l113
+																goto l221
 															}
 															if buffer[position] != rune(':') {
-																goto l113
+																goto l221
 															}
 															position++
 															if !_rules[rulesp]() {
-																goto l113
+																goto l221
 															}
 															if !_rules[ruleExpr]() {
-																goto l113
+																goto l221
 															}
-															goto l112
-														l113:
-															position, tokenIndex, depth = position113, tokenIndex113, depth113
+															goto l220
+														l221:
+															position, tokenIndex = position221, tokenIndex221
 														}
 														if !_rules[rulesp]() {
-															goto l110
+															goto l218
 														}
-														goto l111
-													l110:
-														position, tokenIndex, depth = position110, tokenIndex110, depth110
+														goto l219
+													l218:
+														position, tokenIndex = position218, tokenIndex218
 													}
-												l111:
+												l219:
 													if buffer[position] != rune('}') {
-														goto l56
+														goto l132
 													}
 													position++
 													{
-														add(ruleAction41, position)
+														add(ruleAction109, position)
 													}
-													depth--
-													add(ruleMap, position108)
+													add(ruleMap, position216)
 												}
-												break
 											case '(':
-												if !_rules[ruleTuple]() {
-													goto l56
-												}
-												break
-											default:
 												{
-													position115 := position
-													depth++
+													position223 := position
 													{
-														add(ruleAction36, position)
+														add(ruleAction106, position)
 													}
-													if buffer[position] != rune('[') {
-														goto l56
+													if buffer[position] != rune('(') {
+														goto l132
 													}
 													position++
 													if !_rules[rulesp]() {
-														goto l56
+														goto l132
 													}
 													{
-														position117, tokenIndex117, depth117 := position, tokenIndex, depth
+														position225, tokenIndex225 := position, tokenIndex
 														if !_rules[ruleExpr]() {
-															goto l117
+															goto l225
 														}
-													l119:
+													l227:
 														{
-															position120, tokenIndex120, depth120 := position, tokenIndex, depth
+															position228, tokenIndex228 := position, tokenIndex
 															if !_rules[rulesp]() {
-																goto l120
+																goto l228
 															}
 															if buffer[position] != rune(',') {
-																goto l120
+																goto l228
 															}
 															position++
 															if !_rules[rulesp]() {
-																goto l120
+																goto l228
 															}
 															if !_rules[ruleExpr]() {
-																goto l120
+																goto l228
 															}
-															goto l119
-														l120:
-															position, tokenIndex, depth = position120, tokenIndex120, depth120
+															goto l227
+														l228:
+															position, tokenIndex = position228, tokenIndex228
 														}
 														if !_rules[rulesp]() {
-															goto l117
+															goto l225
 														}
-														goto l118
-													l117:
-														position, tokenIndex, depth = position117, tokenIndex117, depth117
+														goto l226
+													l225:
+														position, tokenIndex = position225, tokenIndex225
 													}
-												l118:
-													if buffer[position] != rune(']') {
-														goto l56
+												l226:
+													if buffer[position] != rune(')') {
+														goto l132
 													}
 													position++
 													{
-														add(ruleAction37, position)
+														add(ruleAction107, position)
 													}
-													depth--
-													add(ruleList, position115)
+													add(ruleTuple, position223)
 												}
-												break
-											}
-										}
-
-										depth--
-										add(ruleVector, position106)
-									}
-								}
-							l58:
-								depth--
-								add(ruleLiteral, position57)
-							}
-							goto l55
-						l56:
-							position, tokenIndex, depth = position55, tokenIndex55, depth55
-							if !_rules[ruleRef]() {
-								goto l46
+											default:
+												{
+													position230 := position
+													{
+														add(ruleAction104, position)
+													}
+													if buffer[position] != rune('[') {
+														goto l132
+													}
+													position++
+													if !_rules[rulesp]() {
+														goto l132
+													}
+													{
+														position232, tokenIndex232 := position, tokenIndex
+														if !_rules[ruleExpr]() {
+															goto l232
+														}
+													l234:
+														{
+															position235, tokenIndex235 := position, tokenIndex
+															if !_rules[rulesp]() {
+																goto l235
+															}
+															if buffer[position] != rune(',') {
+																goto l235
+															}
+															position++
+															if !_rules[rulesp]() {
+																goto l235
+															}
+															if !_rules[ruleExpr]() {
+																goto l235
+															}
+															goto l234
+														l235:
+															position, tokenIndex = position235, tokenIndex235
+														}
+														if !_rules[rulesp]() {
+															goto l232
+														}
+														goto l233
+													l232:
+														position, tokenIndex = position232, tokenIndex232
+													}
+												l233:
+													if buffer[position] != rune(']') {
+														goto l132
+													}
+													position++
+													{
+														add(ruleAction105, position)
+													}
+													add(ruleList, position230)
+												}
+											}
+										}
+
+										add(ruleVector, position214)
+									}
+								}
+							l134:
+								add(ruleLiteral, position133)
+							}
+							goto l131
+						l132:
+							position, tokenIndex = position131, tokenIndex131
+							if !_rules[ruleRef]() {
+								goto l55
+							}
+						}
+					l131:
+						add(ruleValue, position130)
+					}
+				}
+			l57:
+			l237:
+				{
+					position238, tokenIndex238 := position, tokenIndex
+					{
+						position239 := position
+						{
+							position240, tokenIndex240 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l241
+							}
+							if buffer[position] != rune('?') {
+								goto l241
+							}
+							position++
+							{
+								add(ruleAction4, position)
+							}
+							goto l240
+						l241:
+							position, tokenIndex = position240, tokenIndex240
+							if buffer[position] != rune('.') {
+								goto l243
+							}
+							position++
+							{
+								position244, tokenIndex244 := position, tokenIndex
+								if buffer[position] != rune('.') {
+									goto l244
+								}
+								position++
+								goto l243
+							l244:
+								position, tokenIndex = position244, tokenIndex244
+							}
+							{
+								position245 := position
+								if !_rules[ruleUsedMember]() {
+									goto l243
+								}
+								add(rulePegText, position245)
+							}
+							{
+								add(ruleAction5, position)
+							}
+							goto l240
+						l243:
+							position, tokenIndex = position240, tokenIndex240
+							if !_rules[rulesp]() {
+								goto l238
+							}
+							if buffer[position] != rune('w') {
+								goto l238
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l238
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l238
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l238
+							}
+							position++
+							{
+								position247, tokenIndex247 := position, tokenIndex
+								if !_rules[ruleNameChar]() {
+									goto l247
+								}
+								goto l238
+							l247:
+								position, tokenIndex = position247, tokenIndex247
+							}
+							if !_rules[rulesp]() {
+								goto l238
+							}
+							{
+								add(ruleAction6, position)
+							}
+							{
+								position249 := position
+								if buffer[position] != rune('{') {
+									goto l238
+								}
+								position++
+								if !_rules[rulesp]() {
+									goto l238
+								}
+								if !_rules[ruleNamedArg]() {
+									goto l238
+								}
+							l250:
+								{
+									position251, tokenIndex251 := position, tokenIndex
+									if !_rules[rulesp]() {
+										goto l251
+									}
+									if buffer[position] != rune(',') {
+										goto l251
+									}
+									position++
+									if !_rules[rulesp]() {
+										goto l251
+									}
+									if !_rules[ruleNamedArg]() {
+										goto l251
+									}
+									goto l250
+								l251:
+									position, tokenIndex = position251, tokenIndex251
+								}
+								{
+									position252, tokenIndex252 := position, tokenIndex
+									if !_rules[rulesp]() {
+										goto l252
+									}
+									if buffer[position] != rune(',') {
+										goto l252
+									}
+									position++
+									goto l253
+								l252:
+									position, tokenIndex = position252, tokenIndex252
+								}
+							l253:
+								if !_rules[rulesp]() {
+									goto l238
+								}
+								if buffer[position] != rune('}') {
+									goto l238
+								}
+								position++
+								add(ruleUpdates, position249)
 							}
+							{
+								add(ruleAction7, position)
+							}
+						}
+					l240:
+						add(rulePostfix, position239)
+					}
+					goto l237
+				l238:
+					position, tokenIndex = position238, tokenIndex238
+				}
+				add(ruleSingle, position56)
+			}
+			return true
+		l55:
+			position, tokenIndex = position55, tokenIndex55
+			return false
+		},
+		/* 6 Postfix <- <((sp '?' Action4) / ('.' !'.' <UsedMember> Action5) / (sp ('w' 'i' 't' 'h') !NameChar sp Action6 Updates Action7))> */
+		nil,
+		/* 7 Updates <- <('{' sp NamedArg (sp ',' sp NamedArg)* (sp ',')? sp '}')> */
+		nil,
+		/* 8 Record <- <(Action8 ('r' 'e' 'c' 'o' 'r' 'd') !NameChar sp '(' sp Field (sp ',' sp Field)* sp ')' Action9)> */
+		nil,
+		/* 9 Field <- <(<(!Keyword UsedMember)> Action10)> */
+		func() bool {
+			position258, tokenIndex258 := position, tokenIndex
+			{
+				position259 := position
+				{
+					position260 := position
+					{
+						position261, tokenIndex261 := position, tokenIndex
+						if !_rules[ruleKeyword]() {
+							goto l261
 						}
-					l55:
-						depth--
-						add(ruleValue, position54)
+						goto l258
+					l261:
+						position, tokenIndex = position261, tokenIndex261
+					}
+					if !_rules[ruleUsedMember]() {
+						goto l258
 					}
+					add(rulePegText, position260)
+				}
+				{
+					add(ruleAction10, position)
 				}
-			l48:
-				depth--
-				add(ruleSingle, position47)
+				add(ruleField, position259)
 			}
 			return true
-		l46:
-			position, tokenIndex, depth = position46, tokenIndex46, depth46
+		l258:
+			position, tokenIndex = position258, tokenIndex258
 			return false
 		},
-		/* 6 Op <- <(Action4 Single (sp BinaryOp sp Expr)+ Action5)> */
+		/* 10 Op <- <(Action11 Single (sp BinaryOp sp Expr)+ Action12)> */
 		func() bool {
-			position122, tokenIndex122, depth122 := position, tokenIndex, depth
+			position263, tokenIndex263 := position, tokenIndex
 			{
-				position123 := position
-				depth++
+				position264 := position
 				{
-					add(ruleAction4, position)
+					add(ruleAction11, position)
 				}
 				if !_rules[ruleSingle]() {
-					goto l122
+					goto l263
 				}
 				if !_rules[rulesp]() {
-					goto l122
+					goto l263
 				}
 				{
-					position127 := position
-					depth++
+					position268 := position
 					{
-						add(ruleAction6, position)
+						add(ruleAction13, position)
 					}
 					{
-						position129 := position
-						depth++
+						position270 := position
 						{
-							position130, tokenIndex130, depth130 := position, tokenIndex, depth
+							position271, tokenIndex271 := position, tokenIndex
 							if buffer[position] != rune('*') {
-								goto l131
+								goto l272
 							}
 							position++
 							if buffer[position] != rune('*') {
-								goto l131
+								goto l272
 							}
 							position++
-							goto l130
-						l131:
-							position, tokenIndex, depth = position130, tokenIndex130, depth130
+							goto l271
+						l272:
+							position, tokenIndex = position271, tokenIndex271
 							if buffer[position] != rune('>') {
-								goto l132
+								goto l273
 							}
 							position++
 							if buffer[position] != rune('=') {
-								goto l132
+								goto l273
 							}
 							position++
-							goto l130
-						l132:
-							position, tokenIndex, depth = position130, tokenIndex130, depth130
+							goto l271
+						l273:
+							position, tokenIndex = position271, tokenIndex271
 							if buffer[position] != rune('<') {
-								goto l133
+								goto l274
 							}
 							position++
 							if buffer[position] != rune('=') {
-								goto l133
+								goto l274
 							}
 							position++
-							goto l130
-						l133:
-							position, tokenIndex, depth = position130, tokenIndex130, depth130
+							goto l271
+						l274:
+							position, tokenIndex = position271, tokenIndex271
 							{
 								switch buffer[position] {
 								case '<':
 									if buffer[position] != rune('<') {
-										goto l122
+										goto l263
 									}
 									position++
-									break
 								case '>':
 									if buffer[position] != rune('>') {
-										goto l122
+										goto l263
 									}
 									position++
-									break
 								case '%':
 									if buffer[position] != rune('%') {
-										goto l122
+										goto l263
 									}
 									position++
-									break
 								case '/':
 									if buffer[position] != rune('/') {
-										goto l122
+										goto l263
 									}
 									position++
-									break
 								case '*':
 									if buffer[position] != rune('*') {
-										goto l122
+										goto l263
 									}
 									position++
-									break
 								case '-':
 									if buffer[position] != rune('-') {
-										goto l122
+										goto l263
 									}
 									position++
-									break
 								case '+':
 									if buffer[position] != rune('+') {
-										goto l122
+										goto l263
 									}
 									position++
-									break
-								default:
+								case '=':
 									if buffer[position] != rune('=') {
-										goto l122
+										goto l263
 									}
 									position++
 									if buffer[position] != rune('=') {
-										goto l122
+										goto l263
+									}
+									position++
+								default:
+									if buffer[position] != rune('.') {
+										goto l263
+									}
+									position++
+									if buffer[position] != rune('.') {
+										goto l263
 									}
 									position++
-									break
 								}
 							}
 
 						}
-					l130:
-						depth--
-						add(rulePegText, position129)
+					l271:
+						add(rulePegText, position270)
 					}
 					{
-						add(ruleAction7, position)
+						add(ruleAction14, position)
 					}
 					{
-						add(ruleAction8, position)
+						add(ruleAction15, position)
 					}
-					depth--
-					add(ruleBinaryOp, position127)
+					add(ruleBinaryOp, position268)
 				}
 				if !_rules[rulesp]() {
-					goto l122
+					goto l263
 				}
 				if !_rules[ruleExpr]() {
-					goto l122
+					goto l263
 				}
-			l125:
+			l266:
 				{
-					position126, tokenIndex126, depth126 := position, tokenIndex, depth
+					position267, tokenIndex267 := position, tokenIndex
 					if !_rules[rulesp]() {
-						goto l126
+						goto l267
 					}
 					{
-						position137 := position
-						depth++
+						position278 := position
 						{
-							add(ruleAction6, position)
+							add(ruleAction13, position)
 						}
 						{
-							position139 := position
-							depth++
+							position280 := position
 							{
-								position140, tokenIndex140, depth140 := position, tokenIndex, depth
+								position281, tokenIndex281 := position, tokenIndex
 								if buffer[position] != rune('*') {
-									goto l141
+									goto l282
 								}
 								position++
 								if buffer[position] != rune('*') {
-									goto l141
+									goto l282
 								}
 								position++
-								goto l140
-							l141:
-								position, tokenIndex, depth = position140, tokenIndex140, depth140
+								goto l281
+							l282:
+								position, tokenIndex = position281, tokenIndex281
 								if buffer[position] != rune('>') {
-									goto l142
+									goto l283
 								}
 								position++
 								if buffer[position] != rune('=') {
-									goto l142
+									goto l283
 								}
 								position++
-								goto l140
-							l142:
-								position, tokenIndex, depth = position140, tokenIndex140, depth140
+								goto l281
+							l283:
+								position, tokenIndex = position281, tokenIndex281
 								if buffer[position] != rune('<') {
-									goto l143
+									goto l284
 								}
 								position++
 								if buffer[position] != rune('=') {
-									goto l143
+									goto l284
 								}
 								position++
-								goto l140
-							l143:
-								position, tokenIndex, depth = position140, tokenIndex140, depth140
+								goto l281
+							l284:
+								position, tokenIndex = position281, tokenIndex281
 								{
 									switch buffer[position] {
 									case '<':
 										if buffer[position] != rune('<') {
-											goto l126
+											goto l267
 										}
 										position++
-										break
 									case '>':
 										if buffer[position] != rune('>') {
-											goto l126
+											goto l267
 										}
 										position++
-										break
 									case '%':
 										if buffer[position] != rune('%') {
-											goto l126
+											goto l267
 										}
 										position++
-										break
 									case '/':
 										if buffer[position] != rune('/') {
-											goto l126
+											goto l267
 										}
 										position++
-										break
 									case '*':
 										if buffer[position] != rune('*') {
-											goto l126
+											goto l267
 										}
 										position++
-										break
 									case '-':
 										if buffer[position] != rune('-') {
-											goto l126
+											goto l267
 										}
 										position++
-										break
 									case '+':
 										if buffer[position] != rune('+') {
-											goto l126
+											goto l267
 										}
 										position++
-										break
-									default:
+									case '=':
 										if buffer[position] != rune('=') {
-											goto l126
+											goto l267
 										}
 										position++
 										if buffer[position] != rune('=') {
-											goto l126
+											goto l267
+										}
+										position++
+									default:
+										if buffer[position] != rune('.') {
+											goto l267
+										}
+										position++
+										if buffer[position] != rune('.') {
+											goto l267
 										}
 										position++
-										break
 									}
 								}
 
 							}
-						l140:
-							depth--
-							add(rulePegText, position139)
+						l281:
+							add(rulePegText, position280)
 						}
 						{
-							add(ruleAction7, position)
+							add(ruleAction14, position)
 						}
 						{
-							add(ruleAction8, position)
+							add(ruleAction15, position)
 						}
-						depth--
-						add(ruleBinaryOp, position137)
+						add(ruleBinaryOp, position278)
 					}
 					if !_rules[rulesp]() {
-						goto l126
+						goto l267
 					}
 					if !_rules[ruleExpr]() {
-						goto l126
+						goto l267
 					}
-					goto l125
-				l126:
-					position, tokenIndex, depth = position126, tokenIndex126, depth126
+					goto l266
+				l267:
+					position, tokenIndex = position267, tokenIndex267
 				}
 				{
-					add(ruleAction5, position)
+					add(ruleAction12, position)
 				}
-				depth--
-				add(ruleOp, position123)
+				add(ruleOp, position264)
 			}
 			return true
-		l122:
-			position, tokenIndex, depth = position122, tokenIndex122, depth122
+		l263:
+			position, tokenIndex = position263, tokenIndex263
 			return false
 		},
-		/* 7 BinaryOp <- <(Action6 <(('*' '*') / ('>' '=') / ('<' '=') / ((&('<') '<') | (&('>') '>') | (&('%') '%') | (&('/') '/') | (&('*') '*') | (&('-') '-') | (&('+') '+') | (&('=') ('=' '='))))> Action7 Action8)> */
+		/* 11 BinaryOp <- <(Action13 <(('*' '*') / ('>' '=') / ('<' '=') / ((&('<') '<') | (&('>') '>') | (&('%') '%') | (&('/') '/') | (&('*') '*') | (&('-') '-') | (&('+') '+') | (&('=') ('=' '=')) | (&('.') ('.' '.'))))> Action14 Action15)> */
 		nil,
-		/* 8 Statement <- <(Assignment / If)> */
+		/* 12 Statement <- <(Assignment / Break / Continue)> */
 		nil,
-		/* 9 Assignment <- <(Action9 LocalRef sp '=' sp Expr Action10)> */
+		/* 13 Assignment <- <(Action16 Target sp '=' sp Expr Action17)> */
 		nil,
-		/* 10 If <- <(Action11 ('i' 'f') sp Expr sp Block (sp ('e' 'l' 's' 'e') sp Block)? Action12)> */
+		/* 14 Target <- <((&('{') MapPat) | (&('(') TuplePat) | (&('[') ListPat) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') LocalRef))> */
 		func() bool {
-			position151, tokenIndex151, depth151 := position, tokenIndex, depth
+			position292, tokenIndex292 := position, tokenIndex
 			{
-				position152 := position
-				depth++
+				position293 := position
 				{
-					add(ruleAction11, position)
+					switch buffer[position] {
+					case '{':
+						if !_rules[ruleMapPat]() {
+							goto l292
+						}
+					case '(':
+						if !_rules[ruleTuplePat]() {
+							goto l292
+						}
+					case '[':
+						if !_rules[ruleListPat]() {
+							goto l292
+						}
+					default:
+						if !_rules[ruleLocalRef]() {
+							goto l292
+						}
+					}
+				}
+
+				add(ruleTarget, position293)
+			}
+			return true
+		l292:
+			position, tokenIndex = position292, tokenIndex292
+			return false
+		},
+		/* 15 If <- <(Action18 ('i' 'f') !NameChar sp Expr sp Block (sp ElseIf)* (sp ('e' 'l' 's' 'e') !NameChar sp Block)? Action19)> */
+		nil,
+		/* 16 ElseIf <- <('e' 'l' 's' 'e' !NameChar sp ('i' 'f') !NameChar sp Expr sp Block)> */
+		nil,
+		/* 17 For <- <(Action20 ('f' 'o' 'r') !NameChar sp Target sp ('i' 'n') !NameChar sp Expr sp Block Action21)> */
+		nil,
+		/* 18 While <- <(Action22 ('w' 'h' 'i' 'l' 'e') !NameChar sp Expr sp Block Action23)> */
+		nil,
+		/* 19 Break <- <(Action24 ('b' 'r' 'e' 'a' 'k') !NameChar Action25)> */
+		nil,
+		/* 20 Continue <- <(Action26 ('c' 'o' 'n' 't' 'i' 'n' 'u' 'e') !NameChar Action27)> */
+		nil,
+		/* 21 Try <- <(Action28 ('t' 'r' 'y') !NameChar sp Block (((sp Catch)? sp Finally) / (sp Catch)) Action29)> */
+		nil,
+		/* 22 Catch <- <(Action30 ('c' 'a' 't' 'c' 'h') !NameChar sp Target sp Block Action31)> */
+		func() bool {
+			position302, tokenIndex302 := position, tokenIndex
+			{
+				position303 := position
+				{
+					add(ruleAction30, position)
+				}
+				if buffer[position] != rune('c') {
+					goto l302
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l302
 				}
-				if buffer[position] != rune('i') {
-					goto l151
+				position++
+				if buffer[position] != rune('t') {
+					goto l302
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l302
 				}
 				position++
-				if buffer[position] != rune('f') {
-					goto l151
+				if buffer[position] != rune('h') {
+					goto l302
 				}
 				position++
+				{
+					position305, tokenIndex305 := position, tokenIndex
+					if !_rules[ruleNameChar]() {
+						goto l305
+					}
+					goto l302
+				l305:
+					position, tokenIndex = position305, tokenIndex305
+				}
 				if !_rules[rulesp]() {
-					goto l151
+					goto l302
 				}
-				if !_rules[ruleExpr]() {
-					goto l151
+				if !_rules[ruleTarget]() {
+					goto l302
 				}
 				if !_rules[rulesp]() {
-					goto l151
+					goto l302
 				}
 				if !_rules[ruleBlock]() {
-					goto l151
+					goto l302
+				}
+				{
+					add(ruleAction31, position)
+				}
+				add(ruleCatch, position303)
+			}
+			return true
+		l302:
+			position, tokenIndex = position302, tokenIndex302
+			return false
+		},
+		/* 23 Finally <- <(Action32 ('f' 'i' 'n' 'a' 'l' 'l' 'y') !NameChar sp Block Action33)> */
+		nil,
+		/* 24 Match <- <(Action34 <('m' 'a' 't' 'c' 'h')> Action35 !NameChar sp Expr sp '{' sp Case (sp ',' sp Case)* (sp ',')? sp '}' Action36 Action37)> */
+		nil,
+		/* 25 Case <- <(Action38 Pattern (sp Guard)? sp ('-' '>') sp (Block / Expr) Action39)> */
+		func() bool {
+			position309, tokenIndex309 := position, tokenIndex
+			{
+				position310 := position
+				{
+					add(ruleAction38, position)
+				}
+				if !_rules[rulePattern]() {
+					goto l309
+				}
+				{
+					position312, tokenIndex312 := position, tokenIndex
+					if !_rules[rulesp]() {
+						goto l312
+					}
+					{
+						position314 := position
+						{
+							add(ruleAction40, position)
+						}
+						if buffer[position] != rune('i') {
+							goto l312
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l312
+						}
+						position++
+						{
+							position316, tokenIndex316 := position, tokenIndex
+							if !_rules[ruleNameChar]() {
+								goto l316
+							}
+							goto l312
+						l316:
+							position, tokenIndex = position316, tokenIndex316
+						}
+						if !_rules[rulesp]() {
+							goto l312
+						}
+						if !_rules[ruleExpr]() {
+							goto l312
+						}
+						{
+							add(ruleAction41, position)
+						}
+						add(ruleGuard, position314)
+					}
+					goto l313
+				l312:
+					position, tokenIndex = position312, tokenIndex312
+				}
+			l313:
+				if !_rules[rulesp]() {
+					goto l309
+				}
+				if buffer[position] != rune('-') {
+					goto l309
+				}
+				position++
+				if buffer[position] != rune('>') {
+					goto l309
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l309
+				}
+				{
+					position318, tokenIndex318 := position, tokenIndex
+					if !_rules[ruleBlock]() {
+						goto l319
+					}
+					goto l318
+				l319:
+					position, tokenIndex = position318, tokenIndex318
+					if !_rules[ruleExpr]() {
+						goto l309
+					}
+				}
+			l318:
+				{
+					add(ruleAction39, position)
+				}
+				add(ruleCase, position310)
+			}
+			return true
+		l309:
+			position, tokenIndex = position309, tokenIndex309
+			return false
+		},
+		/* 26 Guard <- <(Action40 ('i' 'f') !NameChar sp Expr Action41)> */
+		nil,
+		/* 27 Pattern <- <(Wildcard / PatLiteral / ((&('{') MapPat) | (&('(') TuplePat) | (&('[') ListPat) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') LocalRef)))> */
+		func() bool {
+			position322, tokenIndex322 := position, tokenIndex
+			{
+				position323 := position
+				{
+					position324, tokenIndex324 := position, tokenIndex
+					if !_rules[ruleWildcard]() {
+						goto l325
+					}
+					goto l324
+				l325:
+					position, tokenIndex = position324, tokenIndex324
+					if !_rules[rulePatLiteral]() {
+						goto l326
+					}
+					goto l324
+				l326:
+					position, tokenIndex = position324, tokenIndex324
+					{
+						switch buffer[position] {
+						case '{':
+							if !_rules[ruleMapPat]() {
+								goto l322
+							}
+						case '(':
+							if !_rules[ruleTuplePat]() {
+								goto l322
+							}
+						case '[':
+							if !_rules[ruleListPat]() {
+								goto l322
+							}
+						default:
+							if !_rules[ruleLocalRef]() {
+								goto l322
+							}
+						}
+					}
+
+				}
+			l324:
+				add(rulePattern, position323)
+			}
+			return true
+		l322:
+			position, tokenIndex = position322, tokenIndex322
+			return false
+		},
+		/* 28 Wildcard <- <(Action42 '_' !NameChar Action43)> */
+		func() bool {
+			position328, tokenIndex328 := position, tokenIndex
+			{
+				position329 := position
+				{
+					add(ruleAction42, position)
+				}
+				if buffer[position] != rune('_') {
+					goto l328
+				}
+				position++
+				{
+					position331, tokenIndex331 := position, tokenIndex
+					if !_rules[ruleNameChar]() {
+						goto l331
+					}
+					goto l328
+				l331:
+					position, tokenIndex = position331, tokenIndex331
+				}
+				{
+					add(ruleAction43, position)
+				}
+				add(ruleWildcard, position329)
+			}
+			return true
+		l328:
+			position, tokenIndex = position328, tokenIndex328
+			return false
+		},
+		/* 29 PatLiteral <- <(TextBlock / ((&('f' | 't') Boolean) | (&('"') PlainStr) | (&('`') RawString) | (&('b') Bytes) | (&('-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') Numeric)))> */
+		func() bool {
+			position333, tokenIndex333 := position, tokenIndex
+			{
+				position334 := position
+				{
+					position335, tokenIndex335 := position, tokenIndex
+					if !_rules[ruleTextBlock]() {
+						goto l336
+					}
+					goto l335
+				l336:
+					position, tokenIndex = position335, tokenIndex335
+					{
+						switch buffer[position] {
+						case 'f', 't':
+							if !_rules[ruleBoolean]() {
+								goto l333
+							}
+						case '"':
+							if !_rules[rulePlainStr]() {
+								goto l333
+							}
+						case '`':
+							if !_rules[ruleRawString]() {
+								goto l333
+							}
+						case 'b':
+							if !_rules[ruleBytes]() {
+								goto l333
+							}
+						default:
+							if !_rules[ruleNumeric]() {
+								goto l333
+							}
+						}
+					}
+
+				}
+			l335:
+				add(rulePatLiteral, position334)
+			}
+			return true
+		l333:
+			position, tokenIndex = position333, tokenIndex333
+			return false
+		},
+		/* 30 ListPat <- <(Action44 '[' sp (PatElem (sp ',' sp PatElem)* sp)? ']' Action45)> */
+		func() bool {
+			position338, tokenIndex338 := position, tokenIndex
+			{
+				position339 := position
+				{
+					add(ruleAction44, position)
+				}
+				if buffer[position] != rune('[') {
+					goto l338
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l338
+				}
+				{
+					position341, tokenIndex341 := position, tokenIndex
+					if !_rules[rulePatElem]() {
+						goto l341
+					}
+				l343:
+					{
+						position344, tokenIndex344 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l344
+						}
+						if buffer[position] != rune(',') {
+							goto l344
+						}
+						position++
+						if !_rules[rulesp]() {
+							goto l344
+						}
+						if !_rules[rulePatElem]() {
+							goto l344
+						}
+						goto l343
+					l344:
+						position, tokenIndex = position344, tokenIndex344
+					}
+					if !_rules[rulesp]() {
+						goto l341
+					}
+					goto l342
+				l341:
+					position, tokenIndex = position341, tokenIndex341
+				}
+			l342:
+				if buffer[position] != rune(']') {
+					goto l338
+				}
+				position++
+				{
+					add(ruleAction45, position)
+				}
+				add(ruleListPat, position339)
+			}
+			return true
+		l338:
+			position, tokenIndex = position338, tokenIndex338
+			return false
+		},
+		/* 31 TuplePat <- <(Action46 '(' sp (PatElem (sp ',' sp PatElem)* sp)? ')' Action47)> */
+		func() bool {
+			position346, tokenIndex346 := position, tokenIndex
+			{
+				position347 := position
+				{
+					add(ruleAction46, position)
+				}
+				if buffer[position] != rune('(') {
+					goto l346
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l346
+				}
+				{
+					position349, tokenIndex349 := position, tokenIndex
+					if !_rules[rulePatElem]() {
+						goto l349
+					}
+				l351:
+					{
+						position352, tokenIndex352 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l352
+						}
+						if buffer[position] != rune(',') {
+							goto l352
+						}
+						position++
+						if !_rules[rulesp]() {
+							goto l352
+						}
+						if !_rules[rulePatElem]() {
+							goto l352
+						}
+						goto l351
+					l352:
+						position, tokenIndex = position352, tokenIndex352
+					}
+					if !_rules[rulesp]() {
+						goto l349
+					}
+					goto l350
+				l349:
+					position, tokenIndex = position349, tokenIndex349
+				}
+			l350:
+				if buffer[position] != rune(')') {
+					goto l346
+				}
+				position++
+				{
+					add(ruleAction47, position)
+				}
+				add(ruleTuplePat, position347)
+			}
+			return true
+		l346:
+			position, tokenIndex = position346, tokenIndex346
+			return false
+		},
+		/* 32 PatElem <- <(RestPat / Pattern)> */
+		func() bool {
+			position354, tokenIndex354 := position, tokenIndex
+			{
+				position355 := position
+				{
+					position356, tokenIndex356 := position, tokenIndex
+					if !_rules[ruleRestPat]() {
+						goto l357
+					}
+					goto l356
+				l357:
+					position, tokenIndex = position356, tokenIndex356
+					if !_rules[rulePattern]() {
+						goto l354
+					}
+				}
+			l356:
+				add(rulePatElem, position355)
+			}
+			return true
+		l354:
+			position, tokenIndex = position354, tokenIndex354
+			return false
+		},
+		/* 33 RestPat <- <(Action48 ('.' '.' '.') (Wildcard / LocalRef) Action49)> */
+		func() bool {
+			position358, tokenIndex358 := position, tokenIndex
+			{
+				position359 := position
+				{
+					add(ruleAction48, position)
+				}
+				if buffer[position] != rune('.') {
+					goto l358
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l358
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l358
+				}
+				position++
+				{
+					position361, tokenIndex361 := position, tokenIndex
+					if !_rules[ruleWildcard]() {
+						goto l362
+					}
+					goto l361
+				l362:
+					position, tokenIndex = position361, tokenIndex361
+					if !_rules[ruleLocalRef]() {
+						goto l358
+					}
+				}
+			l361:
+				{
+					add(ruleAction49, position)
+				}
+				add(ruleRestPat, position359)
+			}
+			return true
+		l358:
+			position, tokenIndex = position358, tokenIndex358
+			return false
+		},
+		/* 34 MapPat <- <(Action50 '{' sp (MapPatEntry (sp ',' sp MapPatEntry)* sp)? '}' Action51)> */
+		func() bool {
+			position364, tokenIndex364 := position, tokenIndex
+			{
+				position365 := position
+				{
+					add(ruleAction50, position)
+				}
+				if buffer[position] != rune('{') {
+					goto l364
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l364
+				}
+				{
+					position367, tokenIndex367 := position, tokenIndex
+					if !_rules[ruleMapPatEntry]() {
+						goto l367
+					}
+				l369:
+					{
+						position370, tokenIndex370 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l370
+						}
+						if buffer[position] != rune(',') {
+							goto l370
+						}
+						position++
+						if !_rules[rulesp]() {
+							goto l370
+						}
+						if !_rules[ruleMapPatEntry]() {
+							goto l370
+						}
+						goto l369
+					l370:
+						position, tokenIndex = position370, tokenIndex370
+					}
+					if !_rules[rulesp]() {
+						goto l367
+					}
+					goto l368
+				l367:
+					position, tokenIndex = position367, tokenIndex367
+				}
+			l368:
+				if buffer[position] != rune('}') {
+					goto l364
+				}
+				position++
+				{
+					add(ruleAction51, position)
+				}
+				add(ruleMapPat, position365)
+			}
+			return true
+		l364:
+			position, tokenIndex = position364, tokenIndex364
+			return false
+		},
+		/* 35 MapPatEntry <- <(Action52 ((PatLiteral sp ':' sp Pattern) / LocalRef) Action53)> */
+		func() bool {
+			position372, tokenIndex372 := position, tokenIndex
+			{
+				position373 := position
+				{
+					add(ruleAction52, position)
+				}
+				{
+					position375, tokenIndex375 := position, tokenIndex
+					if !_rules[rulePatLiteral]() {
+						goto l376
+					}
+					if !_rules[rulesp]() {
+						goto l376
+					}
+					if buffer[position] != rune(':') {
+						goto l376
+					}
+					position++
+					if !_rules[rulesp]() {
+						goto l376
+					}
+					if !_rules[rulePattern]() {
+						goto l376
+					}
+					goto l375
+				l376:
+					position, tokenIndex = position375, tokenIndex375
+					if !_rules[ruleLocalRef]() {
+						goto l372
+					}
+				}
+			l375:
+				{
+					add(ruleAction53, position)
+				}
+				add(ruleMapPatEntry, position373)
+			}
+			return true
+		l372:
+			position, tokenIndex = position372, tokenIndex372
+			return false
+		},
+		/* 36 Ref <- <(FullRef / UsedRef)> */
+		func() bool {
+			position378, tokenIndex378 := position, tokenIndex
+			{
+				position379 := position
+				{
+					position380, tokenIndex380 := position, tokenIndex
+					{
+						position382 := position
+						{
+							add(ruleAction54, position)
+						}
+						{
+							position384 := position
+							if !_rules[ruleName]() {
+								goto l381
+							}
+							add(rulePegText, position384)
+						}
+						{
+							add(ruleAction55, position)
+						}
+						if buffer[position] != rune(':') {
+							goto l381
+						}
+						position++
+						{
+							position386, tokenIndex386 := position, tokenIndex
+							if !(p.interpolating > 0) {
+								goto l386
+							}
+							{
+								position387, tokenIndex387 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l388
+								}
+								position++
+								goto l387
+							l388:
+								position, tokenIndex = position387, tokenIndex387
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l386
+								}
+								position++
+							}
+						l387:
+							if buffer[position] != rune('}') {
+								goto l386
+							}
+							position++
+							goto l381
+						l386:
+							position, tokenIndex = position386, tokenIndex386
+						}
+						{
+							position389 := position
+							if !_rules[ruleUsedMember]() {
+								goto l381
+							}
+							add(rulePegText, position389)
+						}
+						{
+							add(ruleAction56, position)
+						}
+						{
+							add(ruleAction57, position)
+						}
+						add(ruleFullRef, position382)
+					}
+					goto l380
+				l381:
+					position, tokenIndex = position380, tokenIndex380
+					{
+						position392 := position
+						{
+							add(ruleAction58, position)
+						}
+						{
+							position394 := position
+							{
+								position395, tokenIndex395 := position, tokenIndex
+								if !_rules[ruleKeyword]() {
+									goto l395
+								}
+								goto l378
+							l395:
+								position, tokenIndex = position395, tokenIndex395
+							}
+							if !_rules[ruleUsedMember]() {
+								goto l378
+							}
+							add(rulePegText, position394)
+						}
+						{
+							add(ruleAction59, position)
+						}
+						{
+							add(ruleAction60, position)
+						}
+						add(ruleUsedRef, position392)
+					}
+				}
+			l380:
+				add(ruleRef, position379)
+			}
+			return true
+		l378:
+			position, tokenIndex = position378, tokenIndex378
+			return false
+		},
+		/* 37 FullRef <- <(Action54 <Name> Action55 ':' !(&{ p.interpolating > 0 } ([a-z] / [A-Z]) '}') <UsedMember> Action56 Action57)> */
+		nil,
+		/* 38 UsedRef <- <(Action58 <(!Keyword UsedMember)> Action59 Action60)> */
+		nil,
+		/* 39 LocalRef <- <(Action61 <Name> Action62 Action63)> */
+		func() bool {
+			position400, tokenIndex400 := position, tokenIndex
+			{
+				position401 := position
+				{
+					add(ruleAction61, position)
+				}
+				{
+					position403 := position
+					if !_rules[ruleName]() {
+						goto l400
+					}
+					add(rulePegText, position403)
+				}
+				{
+					add(ruleAction62, position)
+				}
+				{
+					add(ruleAction63, position)
+				}
+				add(ruleLocalRef, position401)
+			}
+			return true
+		l400:
+			position, tokenIndex = position400, tokenIndex400
+			return false
+		},
+		/* 40 Name <- <(!Keyword Member)> */
+		func() bool {
+			position406, tokenIndex406 := position, tokenIndex
+			{
+				position407 := position
+				{
+					position408, tokenIndex408 := position, tokenIndex
+					if !_rules[ruleKeyword]() {
+						goto l408
+					}
+					goto l406
+				l408:
+					position, tokenIndex = position408, tokenIndex408
+				}
+				{
+					position409 := position
+					if !_rules[ruleRefChar]() {
+						goto l406
+					}
+				l410:
+					{
+						position411, tokenIndex411 := position, tokenIndex
+						if !_rules[ruleNameChar]() {
+							goto l411
+						}
+						goto l410
+					l411:
+						position, tokenIndex = position411, tokenIndex411
+					}
+					{
+						position412, tokenIndex412 := position, tokenIndex
+						{
+							position414, tokenIndex414 := position, tokenIndex
+							if buffer[position] != rune('?') {
+								goto l415
+							}
+							position++
+							goto l414
+						l415:
+							position, tokenIndex = position414, tokenIndex414
+							if buffer[position] != rune('!') {
+								goto l412
+							}
+							position++
+						}
+					l414:
+						goto l413
+					l412:
+						position, tokenIndex = position412, tokenIndex412
+					}
+				l413:
+					add(ruleMember, position409)
+				}
+				add(ruleName, position407)
+			}
+			return true
+		l406:
+			position, tokenIndex = position406, tokenIndex406
+			return false
+		},
+		/* 41 Member <- <(RefChar NameChar* ('?' / '!')?)> */
+		nil,
+		/* 42 UsedMember <- <(RefChar NameChar* ('!' / ('?' &'('))?)> */
+		func() bool {
+			position417, tokenIndex417 := position, tokenIndex
+			{
+				position418 := position
+				if !_rules[ruleRefChar]() {
+					goto l417
+				}
+			l419:
+				{
+					position420, tokenIndex420 := position, tokenIndex
+					if !_rules[ruleNameChar]() {
+						goto l420
+					}
+					goto l419
+				l420:
+					position, tokenIndex = position420, tokenIndex420
+				}
+				{
+					position421, tokenIndex421 := position, tokenIndex
+					{
+						position423, tokenIndex423 := position, tokenIndex
+						if buffer[position] != rune('!') {
+							goto l424
+						}
+						position++
+						goto l423
+					l424:
+						position, tokenIndex = position423, tokenIndex423
+						if buffer[position] != rune('?') {
+							goto l421
+						}
+						position++
+						{
+							position425, tokenIndex425 := position, tokenIndex
+							if buffer[position] != rune('(') {
+								goto l421
+							}
+							position++
+							position, tokenIndex = position425, tokenIndex425
+						}
+					}
+				l423:
+					goto l422
+				l421:
+					position, tokenIndex = position421, tokenIndex421
+				}
+			l422:
+				add(ruleUsedMember, position418)
+			}
+			return true
+		l417:
+			position, tokenIndex = position417, tokenIndex417
+			return false
+		},
+		/* 43 NameChar <- <(RefChar / Digit)> */
+		func() bool {
+			position426, tokenIndex426 := position, tokenIndex
+			{
+				position427 := position
+				{
+					position428, tokenIndex428 := position, tokenIndex
+					if !_rules[ruleRefChar]() {
+						goto l429
+					}
+					goto l428
+				l429:
+					position, tokenIndex = position428, tokenIndex428
+					if !_rules[ruleDigit]() {
+						goto l426
+					}
+				}
+			l428:
+				add(ruleNameChar, position427)
+			}
+			return true
+		l426:
+			position, tokenIndex = position426, tokenIndex426
+			return false
+		},
+		/* 44 RefChar <- <((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		func() bool {
+			position430, tokenIndex430 := position, tokenIndex
+			{
+				position431 := position
+				{
+					switch buffer[position] {
+					case '_':
+						if buffer[position] != rune('_') {
+							goto l430
+						}
+						position++
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l430
+						}
+						position++
+					default:
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l430
+						}
+						position++
+					}
+				}
+
+				add(ruleRefChar, position431)
+			}
+			return true
+		l430:
+			position, tokenIndex = position430, tokenIndex430
+			return false
+		},
+		/* 45 Keyword <- <((('i' 'f') / ('t' 'r' 'u' 'e') / ('f' 'a' 'l' 's' 'e') / ('f' 'o' 'r') / ('w' 'h' 'i' 'l' 'e') / ('c' 'o' 'n' 't' 'i' 'n' 'u' 'e') / ((&('w') ('w' 'i' 't' 'h')) | (&('r') ('r' 'e' 'c' 'o' 'r' 'd')) | (&('f') ('f' 'i' 'n' 'a' 'l' 'l' 'y')) | (&('c') ('c' 'a' 't' 'c' 'h')) | (&('t') ('t' 'r' 'y')) | (&('b') ('b' 'r' 'e' 'a' 'k')) | (&('i') ('i' 'n')) | (&('m') ('m' 'a' 't' 'c' 'h')) | (&('e') ('e' 'l' 's' 'e')))) !NameChar)> */
+		func() bool {
+			position433, tokenIndex433 := position, tokenIndex
+			{
+				position434 := position
+				{
+					position435, tokenIndex435 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l436
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l436
+					}
+					position++
+					goto l435
+				l436:
+					position, tokenIndex = position435, tokenIndex435
+					if buffer[position] != rune('t') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l437
+					}
+					position++
+					goto l435
+				l437:
+					position, tokenIndex = position435, tokenIndex435
+					if buffer[position] != rune('f') {
+						goto l438
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l438
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l438
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l438
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l438
+					}
+					position++
+					goto l435
+				l438:
+					position, tokenIndex = position435, tokenIndex435
+					if buffer[position] != rune('f') {
+						goto l439
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l439
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l439
+					}
+					position++
+					goto l435
+				l439:
+					position, tokenIndex = position435, tokenIndex435
+					if buffer[position] != rune('w') {
+						goto l440
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l440
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l440
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l440
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l440
+					}
+					position++
+					goto l435
+				l440:
+					position, tokenIndex = position435, tokenIndex435
+					if buffer[position] != rune('c') {
+						goto l441
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l441
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l441
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l441
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l441
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l441
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l441
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l441
+					}
+					position++
+					goto l435
+				l441:
+					position, tokenIndex = position435, tokenIndex435
+					{
+						switch buffer[position] {
+						case 'w':
+							if buffer[position] != rune('w') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l433
+							}
+							position++
+						case 'r':
+							if buffer[position] != rune('r') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l433
+							}
+							position++
+						case 'f':
+							if buffer[position] != rune('f') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l433
+							}
+							position++
+						case 'c':
+							if buffer[position] != rune('c') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l433
+							}
+							position++
+						case 't':
+							if buffer[position] != rune('t') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l433
+							}
+							position++
+						case 'b':
+							if buffer[position] != rune('b') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('k') {
+								goto l433
+							}
+							position++
+						case 'i':
+							if buffer[position] != rune('i') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l433
+							}
+							position++
+						case 'm':
+							if buffer[position] != rune('m') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l433
+							}
+							position++
+						default:
+							if buffer[position] != rune('e') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l433
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l433
+							}
+							position++
+						}
+					}
+
+				}
+			l435:
+				{
+					position443, tokenIndex443 := position, tokenIndex
+					if !_rules[ruleNameChar]() {
+						goto l443
+					}
+					goto l433
+				l443:
+					position, tokenIndex = position443, tokenIndex443
+				}
+				add(ruleKeyword, position434)
+			}
+			return true
+		l433:
+			position, tokenIndex = position433, tokenIndex433
+			return false
+		},
+		/* 46 Value <- <(Literal / Ref)> */
+		nil,
+		/* 47 Literal <- <(Func / Scalar / Vector)> */
+		nil,
+		/* 48 Scalar <- <((&('f' | 't') Boolean) | (&('b') Bytes) | (&('"' | '`') String) | (&('-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') Numeric))> */
+		nil,
+		/* 49 Vector <- <((&('{') Map) | (&('(') Tuple) | (&('[') List))> */
+		nil,
+		/* 50 String <- <(RawString / TextBlock / QuotedStr)> */
+		nil,
+		/* 51 QuotedStr <- <(PlainStr / Interpolated)> */
+		nil,
+		/* 52 PlainStr <- <(Action64 '"' <StringChar*> '"' Action65 Action66)> */
+		func() bool {
+			position450, tokenIndex450 := position, tokenIndex
+			{
+				position451 := position
+				{
+					add(ruleAction64, position)
+				}
+				if buffer[position] != rune('"') {
+					goto l450
+				}
+				position++
+				{
+					position453 := position
+				l454:
+					{
+						position455, tokenIndex455 := position, tokenIndex
+						if !_rules[ruleStringChar]() {
+							goto l455
+						}
+						goto l454
+					l455:
+						position, tokenIndex = position455, tokenIndex455
+					}
+					add(rulePegText, position453)
+				}
+				if buffer[position] != rune('"') {
+					goto l450
+				}
+				position++
+				{
+					add(ruleAction65, position)
+				}
+				{
+					add(ruleAction66, position)
+				}
+				add(rulePlainStr, position451)
+			}
+			return true
+		l450:
+			position, tokenIndex = position450, tokenIndex450
+			return false
+		},
+		/* 53 StringChar <- <(Escape / (!('$' '{') !((&('\\') '\\') | (&('\n') '\n') | (&('"') '"')) .))> */
+		func() bool {
+			position458, tokenIndex458 := position, tokenIndex
+			{
+				position459 := position
+				{
+					position460, tokenIndex460 := position, tokenIndex
+					if !_rules[ruleEscape]() {
+						goto l461
+					}
+					goto l460
+				l461:
+					position, tokenIndex = position460, tokenIndex460
+					{
+						position462, tokenIndex462 := position, tokenIndex
+						if buffer[position] != rune('$') {
+							goto l462
+						}
+						position++
+						if buffer[position] != rune('{') {
+							goto l462
+						}
+						position++
+						goto l458
+					l462:
+						position, tokenIndex = position462, tokenIndex462
+					}
+					{
+						position463, tokenIndex463 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '\\':
+								if buffer[position] != rune('\\') {
+									goto l463
+								}
+								position++
+							case '\n':
+								if buffer[position] != rune('\n') {
+									goto l463
+								}
+								position++
+							default:
+								if buffer[position] != rune('"') {
+									goto l463
+								}
+								position++
+							}
+						}
+
+						goto l458
+					l463:
+						position, tokenIndex = position463, tokenIndex463
+					}
+					if !matchDot() {
+						goto l458
+					}
+				}
+			l460:
+				add(ruleStringChar, position459)
+			}
+			return true
+		l458:
+			position, tokenIndex = position458, tokenIndex458
+			return false
+		},
+		/* 54 Interpolated <- <(Action67 '"' (StrPart / Interp)* '"' Action68)> */
+		nil,
+		/* 55 StrPart <- <(Action69 <StringChar+> Action70 Action71)> */
+		nil,
+		/* 56 Interp <- <(GoodInterp / BadInterp)> */
+		nil,
+		/* 57 GoodInterp <- <('$' '{' !{ p.interpolating++ } ((InterpBody !{ p.interpolating-- }) / (!{ p.interpolating-- } &{ false })))> */
+		nil,
+		/* 58 InterpBody <- <(Action72 sp Expr sp (':' <FormatSpec> Action73)? '}' Action74)> */
+		nil,
+		/* 59 FormatSpec <- <(((&('0') '0') | (&('#') '#') | (&(' ') ' ') | (&('+') '+') | (&('-') '-'))* Digit* ('.' Digit+)? ([a-z] / [A-Z])?)> */
+		nil,
+		/* 60 BadInterp <- <('$' '{' <(!'}' !'"' !'\n' .)*> '}'? Action75)> */
+		nil,
+		/* 61 TextBlock <- <(Action76 ('"' '"' '"') <BlockChar*> ('"' '"' '"') Action77 Action78)> */
+		func() bool {
+			position472, tokenIndex472 := position, tokenIndex
+			{
+				position473 := position
+				{
+					add(ruleAction76, position)
+				}
+				if buffer[position] != rune('"') {
+					goto l472
+				}
+				position++
+				if buffer[position] != rune('"') {
+					goto l472
+				}
+				position++
+				if buffer[position] != rune('"') {
+					goto l472
+				}
+				position++
+				{
+					position475 := position
+				l476:
+					{
+						position477, tokenIndex477 := position, tokenIndex
+						{
+							position478 := position
+							{
+								position479, tokenIndex479 := position, tokenIndex
+								if !_rules[ruleEscape]() {
+									goto l480
+								}
+								goto l479
+							l480:
+								position, tokenIndex = position479, tokenIndex479
+								{
+									position481, tokenIndex481 := position, tokenIndex
+									if buffer[position] != rune('"') {
+										goto l481
+									}
+									position++
+									if buffer[position] != rune('"') {
+										goto l481
+									}
+									position++
+									if buffer[position] != rune('"') {
+										goto l481
+									}
+									position++
+									goto l477
+								l481:
+									position, tokenIndex = position481, tokenIndex481
+								}
+								{
+									position482, tokenIndex482 := position, tokenIndex
+									if buffer[position] != rune('\\') {
+										goto l482
+									}
+									position++
+									goto l477
+								l482:
+									position, tokenIndex = position482, tokenIndex482
+								}
+								if !matchDot() {
+									goto l477
+								}
+							}
+						l479:
+							add(ruleBlockChar, position478)
+						}
+						goto l476
+					l477:
+						position, tokenIndex = position477, tokenIndex477
+					}
+					add(rulePegText, position475)
+				}
+				if buffer[position] != rune('"') {
+					goto l472
+				}
+				position++
+				if buffer[position] != rune('"') {
+					goto l472
+				}
+				position++
+				if buffer[position] != rune('"') {
+					goto l472
+				}
+				position++
+				{
+					add(ruleAction77, position)
+				}
+				{
+					add(ruleAction78, position)
+				}
+				add(ruleTextBlock, position473)
+			}
+			return true
+		l472:
+			position, tokenIndex = position472, tokenIndex472
+			return false
+		},
+		/* 62 BlockChar <- <(Escape / (!('"' '"' '"') !'\\' .))> */
+		nil,
+		/* 63 RawString <- <(Action79 '`' <(!'`' .)*> '`' Action80 Action81)> */
+		func() bool {
+			position486, tokenIndex486 := position, tokenIndex
+			{
+				position487 := position
+				{
+					add(ruleAction79, position)
+				}
+				if buffer[position] != rune('`') {
+					goto l486
+				}
+				position++
+				{
+					position489 := position
+				l490:
+					{
+						position491, tokenIndex491 := position, tokenIndex
+						{
+							position492, tokenIndex492 := position, tokenIndex
+							if buffer[position] != rune('`') {
+								goto l492
+							}
+							position++
+							goto l491
+						l492:
+							position, tokenIndex = position492, tokenIndex492
+						}
+						if !matchDot() {
+							goto l491
+						}
+						goto l490
+					l491:
+						position, tokenIndex = position491, tokenIndex491
+					}
+					add(rulePegText, position489)
+				}
+				if buffer[position] != rune('`') {
+					goto l486
+				}
+				position++
+				{
+					add(ruleAction80, position)
+				}
+				{
+					add(ruleAction81, position)
+				}
+				add(ruleRawString, position487)
+			}
+			return true
+		l486:
+			position, tokenIndex = position486, tokenIndex486
+			return false
+		},
+		/* 64 Bytes <- <(Action82 ('b' '"') <StringChar*> '"' Action83 Action84)> */
+		func() bool {
+			position495, tokenIndex495 := position, tokenIndex
+			{
+				position496 := position
+				{
+					add(ruleAction82, position)
+				}
+				if buffer[position] != rune('b') {
+					goto l495
+				}
+				position++
+				if buffer[position] != rune('"') {
+					goto l495
+				}
+				position++
+				{
+					position498 := position
+				l499:
+					{
+						position500, tokenIndex500 := position, tokenIndex
+						if !_rules[ruleStringChar]() {
+							goto l500
+						}
+						goto l499
+					l500:
+						position, tokenIndex = position500, tokenIndex500
+					}
+					add(rulePegText, position498)
+				}
+				if buffer[position] != rune('"') {
+					goto l495
+				}
+				position++
+				{
+					add(ruleAction83, position)
+				}
+				{
+					add(ruleAction84, position)
+				}
+				add(ruleBytes, position496)
+			}
+			return true
+		l495:
+			position, tokenIndex = position495, tokenIndex495
+			return false
+		},
+		/* 65 Escape <- <('\\' .)> */
+		func() bool {
+			position503, tokenIndex503 := position, tokenIndex
+			{
+				position504 := position
+				if buffer[position] != rune('\\') {
+					goto l503
+				}
+				position++
+				if !matchDot() {
+					goto l503
+				}
+				add(ruleEscape, position504)
+			}
+			return true
+		l503:
+			position, tokenIndex = position503, tokenIndex503
+			return false
+		},
+		/* 66 Numeric <- <(Action85 <(SciNum / Decimal / Integer)> Action86 Action87)> */
+		func() bool {
+			position505, tokenIndex505 := position, tokenIndex
+			{
+				position506 := position
+				{
+					add(ruleAction85, position)
+				}
+				{
+					position508 := position
+					{
+						position509, tokenIndex509 := position, tokenIndex
+						{
+							position511 := position
+							{
+								position512, tokenIndex512 := position, tokenIndex
+								if !_rules[ruleDecimal]() {
+									goto l513
+								}
+								goto l512
+							l513:
+								position, tokenIndex = position512, tokenIndex512
+								if !_rules[ruleInteger]() {
+									goto l510
+								}
+							}
+						l512:
+							{
+								position514, tokenIndex514 := position, tokenIndex
+								if buffer[position] != rune('e') {
+									goto l515
+								}
+								position++
+								goto l514
+							l515:
+								position, tokenIndex = position514, tokenIndex514
+								if buffer[position] != rune('E') {
+									goto l510
+								}
+								position++
+							}
+						l514:
+							{
+								position516, tokenIndex516 := position, tokenIndex
+								{
+									position518, tokenIndex518 := position, tokenIndex
+									if buffer[position] != rune('-') {
+										goto l519
+									}
+									position++
+									goto l518
+								l519:
+									position, tokenIndex = position518, tokenIndex518
+									if buffer[position] != rune('+') {
+										goto l516
+									}
+									position++
+								}
+							l518:
+								goto l517
+							l516:
+								position, tokenIndex = position516, tokenIndex516
+							}
+						l517:
+							if !_rules[ruleDigit]() {
+								goto l510
+							}
+						l520:
+							{
+								position521, tokenIndex521 := position, tokenIndex
+								if !_rules[ruleDigit]() {
+									goto l521
+								}
+								goto l520
+							l521:
+								position, tokenIndex = position521, tokenIndex521
+							}
+							add(ruleSciNum, position511)
+						}
+						goto l509
+					l510:
+						position, tokenIndex = position509, tokenIndex509
+						if !_rules[ruleDecimal]() {
+							goto l522
+						}
+						goto l509
+					l522:
+						position, tokenIndex = position509, tokenIndex509
+						if !_rules[ruleInteger]() {
+							goto l505
+						}
+					}
+				l509:
+					add(rulePegText, position508)
+				}
+				{
+					add(ruleAction86, position)
+				}
+				{
+					add(ruleAction87, position)
+				}
+				add(ruleNumeric, position506)
+			}
+			return true
+		l505:
+			position, tokenIndex = position505, tokenIndex505
+			return false
+		},
+		/* 67 SciNum <- <((Decimal / Integer) ('e' / 'E') ('-' / '+')? Digit+)> */
+		nil,
+		/* 68 Decimal <- <(Integer '.' !'.' Digit*)> */
+		func() bool {
+			position526, tokenIndex526 := position, tokenIndex
+			{
+				position527 := position
+				if !_rules[ruleInteger]() {
+					goto l526
+				}
+				if buffer[position] != rune('.') {
+					goto l526
 				}
+				position++
 				{
-					position154, tokenIndex154, depth154 := position, tokenIndex, depth
-					if !_rules[rulesp]() {
-						goto l154
-					}
-					if buffer[position] != rune('e') {
-						goto l154
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l154
+					position528, tokenIndex528 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l528
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l154
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l154
-					}
-					position++
-					if !_rules[rulesp]() {
-						goto l154
-					}
-					if !_rules[ruleBlock]() {
-						goto l154
-					}
-					goto l155
-				l154:
-					position, tokenIndex, depth = position154, tokenIndex154, depth154
+					goto l526
+				l528:
+					position, tokenIndex = position528, tokenIndex528
 				}
-			l155:
+			l529:
 				{
-					add(ruleAction12, position)
+					position530, tokenIndex530 := position, tokenIndex
+					if !_rules[ruleDigit]() {
+						goto l530
+					}
+					goto l529
+				l530:
+					position, tokenIndex = position530, tokenIndex530
 				}
-				depth--
-				add(ruleIf, position152)
+				add(ruleDecimal, position527)
 			}
 			return true
-		l151:
-			position, tokenIndex, depth = position151, tokenIndex151, depth151
+		l526:
+			position, tokenIndex = position526, tokenIndex526
 			return false
 		},
-		/* 11 Ref <- <(FullRef / LocalRef)> */
+		/* 69 Integer <- <('-'? WholeNum)> */
 		func() bool {
-			position157, tokenIndex157, depth157 := position, tokenIndex, depth
+			position531, tokenIndex531 := position, tokenIndex
 			{
-				position158 := position
-				depth++
+				position532 := position
+				{
+					position533, tokenIndex533 := position, tokenIndex
+					if buffer[position] != rune('-') {
+						goto l533
+					}
+					position++
+					goto l534
+				l533:
+					position, tokenIndex = position533, tokenIndex533
+				}
+			l534:
 				{
-					position159, tokenIndex159, depth159 := position, tokenIndex, depth
+					position535 := position
 					{
-						position161 := position
-						depth++
-						{
-							add(ruleAction13, position)
-						}
-						{
-							position163 := position
-							depth++
-							if !_rules[ruleRefChar]() {
-								goto l160
-							}
-						l164:
-							{
-								position165, tokenIndex165, depth165 := position, tokenIndex, depth
-								if !_rules[ruleRefChar]() {
-									goto l165
-								}
-								goto l164
-							l165:
-								position, tokenIndex, depth = position165, tokenIndex165, depth165
-							}
-							depth--
-							add(rulePegText, position163)
+						position536, tokenIndex536 := position, tokenIndex
+						if buffer[position] != rune('0') {
+							goto l537
 						}
-						{
-							add(ruleAction14, position)
-						}
-						if buffer[position] != rune(':') {
-							goto l160
+						position++
+						goto l536
+					l537:
+						position, tokenIndex = position536, tokenIndex536
+						if c := buffer[position]; c < rune('1') || c > rune('9') {
+							goto l531
 						}
 						position++
+					l538:
 						{
-							position167 := position
-							depth++
-							if !_rules[ruleRefChar]() {
-								goto l160
-							}
-						l168:
-							{
-								position169, tokenIndex169, depth169 := position, tokenIndex, depth
-								if !_rules[ruleRefChar]() {
-									goto l169
-								}
-								goto l168
-							l169:
-								position, tokenIndex, depth = position169, tokenIndex169, depth169
+							position539, tokenIndex539 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l539
 							}
-							depth--
-							add(rulePegText, position167)
+							goto l538
+						l539:
+							position, tokenIndex = position539, tokenIndex539
 						}
-						{
-							add(ruleAction15, position)
-						}
-						{
-							add(ruleAction16, position)
-						}
-						depth--
-						add(ruleFullRef, position161)
-					}
-					goto l159
-				l160:
-					position, tokenIndex, depth = position159, tokenIndex159, depth159
-					if !_rules[ruleLocalRef]() {
-						goto l157
 					}
+				l536:
+					add(ruleWholeNum, position535)
 				}
-			l159:
-				depth--
-				add(ruleRef, position158)
+				add(ruleInteger, position532)
 			}
 			return true
-		l157:
-			position, tokenIndex, depth = position157, tokenIndex157, depth157
+		l531:
+			position, tokenIndex = position531, tokenIndex531
 			return false
 		},
-		/* 12 FullRef <- <(Action13 <RefChar+> Action14 ':' <RefChar+> Action15 Action16)> */
+		/* 70 WholeNum <- <('0' / ([1-9] Digit*))> */
 		nil,
-		/* 13 LocalRef <- <(Action17 <RefChar+> Action18 Action19)> */
+		/* 71 Digit <- <[0-9]> */
+		func() bool {
+			position541, tokenIndex541 := position, tokenIndex
+			{
+				position542 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l541
+				}
+				position++
+				add(ruleDigit, position542)
+			}
+			return true
+		l541:
+			position, tokenIndex = position541, tokenIndex541
+			return false
+		},
+		/* 72 Boolean <- <(Action88 <((('t' 'r' 'u' 'e') / ('f' 'a' 'l' 's' 'e')) !NameChar)> Action89 Action90)> */
 		func() bool {
-			position173, tokenIndex173, depth173 := position, tokenIndex, depth
+			position543, tokenIndex543 := position, tokenIndex
 			{
-				position174 := position
-				depth++
+				position544 := position
 				{
-					add(ruleAction17, position)
+					add(ruleAction88, position)
 				}
 				{
-					position176 := position
-					depth++
-					if !_rules[ruleRefChar]() {
-						goto l173
+					position546 := position
+					{
+						position547, tokenIndex547 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l548
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l548
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l548
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l548
+						}
+						position++
+						goto l547
+					l548:
+						position, tokenIndex = position547, tokenIndex547
+						if buffer[position] != rune('f') {
+							goto l543
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l543
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l543
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l543
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l543
+						}
+						position++
 					}
-				l177:
+				l547:
 					{
-						position178, tokenIndex178, depth178 := position, tokenIndex, depth
-						if !_rules[ruleRefChar]() {
-							goto l178
+						position549, tokenIndex549 := position, tokenIndex
+						if !_rules[ruleNameChar]() {
+							goto l549
 						}
-						goto l177
-					l178:
-						position, tokenIndex, depth = position178, tokenIndex178, depth178
+						goto l543
+					l549:
+						position, tokenIndex = position549, tokenIndex549
 					}
-					depth--
-					add(rulePegText, position176)
+					add(rulePegText, position546)
 				}
 				{
-					add(ruleAction18, position)
+					add(ruleAction89, position)
 				}
 				{
-					add(ruleAction19, position)
+					add(ruleAction90, position)
 				}
-				depth--
-				add(ruleLocalRef, position174)
+				add(ruleBoolean, position544)
 			}
 			return true
-		l173:
-			position, tokenIndex, depth = position173, tokenIndex173, depth173
+		l543:
+			position, tokenIndex = position543, tokenIndex543
 			return false
 		},
-		/* 14 RefChar <- <((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		/* 73 Func <- <(Action91 FuncArgs sp ('-' '>') sp (Block / Expr) Action92)> */
+		nil,
+		/* 74 FuncArgs <- <(Action93 '(' sp (Params sp)? ')' Action94)> */
+		nil,
+		/* 75 Params <- <(RestPat / (Param (sp ',' sp Param)* (sp ',' sp RestPat)?))> */
+		nil,
+		/* 76 Param <- <(DefaultArg / Target)> */
 		func() bool {
-			position181, tokenIndex181, depth181 := position, tokenIndex, depth
+			position555, tokenIndex555 := position, tokenIndex
 			{
-				position182 := position
-				depth++
+				position556 := position
 				{
-					switch buffer[position] {
-					case '_':
-						if buffer[position] != rune('_') {
-							goto l181
+					position557, tokenIndex557 := position, tokenIndex
+					{
+						position559 := position
+						{
+							add(ruleAction95, position)
 						}
-						position++
-						break
-					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l181
+						if !_rules[ruleLocalRef]() {
+							goto l558
 						}
-						position++
-						break
-					default:
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l181
+						if !_rules[rulesp]() {
+							goto l558
+						}
+						if buffer[position] != rune('=') {
+							goto l558
 						}
 						position++
-						break
+						if !_rules[rulesp]() {
+							goto l558
+						}
+						if !_rules[ruleExpr]() {
+							goto l558
+						}
+						{
+							add(ruleAction96, position)
+						}
+						add(ruleDefaultArg, position559)
+					}
+					goto l557
+				l558:
+					position, tokenIndex = position557, tokenIndex557
+					if !_rules[ruleTarget]() {
+						goto l555
 					}
 				}
-
-				depth--
-				add(ruleRefChar, position182)
+			l557:
+				add(ruleParam, position556)
 			}
 			return true
-		l181:
-			position, tokenIndex, depth = position181, tokenIndex181, depth181
+		l555:
+			position, tokenIndex = position555, tokenIndex555
 			return false
 		},
-		/* 15 Value <- <(Literal / Ref)> */
-		nil,
-		/* 16 Literal <- <(Func / Scalar / Vector)> */
-		nil,
-		/* 17 Scalar <- <((&('f' | 't') Boolean) | (&('"') String) | (&('-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') Numeric))> */
+		/* 77 DefaultArg <- <(Action95 LocalRef sp '=' sp Expr Action96)> */
 		nil,
-		/* 18 Vector <- <((&('{') Map) | (&('(') Tuple) | (&('[') List))> */
+		/* 78 FuncApply <- <(Action97 Ref CallArgs Action98)> */
 		nil,
-		/* 19 String <- <(Action20 '"' <StringChar*> '"' Action21 Action22)> */
+		/* 79 CallArgs <- <(Action99 '(' sp (CallArgList sp)? ')' Action100)> */
 		nil,
-		/* 20 StringChar <- <(StringEsc / (!((&('\\') '\\') | (&('\n') '\n') | (&('"') '"')) .))> */
+		/* 80 CallArgList <- <(NamedArgs / (PosArg (sp ',' sp PosArg)* (sp ',' sp NamedArgs)?))> */
 		nil,
-		/* 21 StringEsc <- <SimpleEsc> */
-		nil,
-		/* 22 SimpleEsc <- <('\\' ((&('v') 'v') | (&('t') 't') | (&('r') 'r') | (&('n') 'n') | (&('f') 'f') | (&('b') 'b') | (&('a') 'a') | (&('\\') '\\') | (&('?') '?') | (&('"') '"') | (&('\'') '\'')))> */
-		nil,
-		/* 23 Numeric <- <(Action23 (SciNum / Decimal / Integer) Action24)> */
-		nil,
-		/* 24 SciNum <- <(Decimal ('e' / 'E') Integer)> */
-		nil,
-		/* 25 Decimal <- <(Integer '.' <Digit*> Action25)> */
+		/* 81 PosArg <- <(!ArgName Expr)> */
 		func() bool {
-			position194, tokenIndex194, depth194 := position, tokenIndex, depth
+			position566, tokenIndex566 := position, tokenIndex
 			{
-				position195 := position
-				depth++
-				if !_rules[ruleInteger]() {
-					goto l194
-				}
-				if buffer[position] != rune('.') {
-					goto l194
-				}
-				position++
+				position567 := position
 				{
-					position196 := position
-					depth++
-				l197:
-					{
-						position198, tokenIndex198, depth198 := position, tokenIndex, depth
-						if !_rules[ruleDigit]() {
-							goto l198
-						}
-						goto l197
-					l198:
-						position, tokenIndex, depth = position198, tokenIndex198, depth198
+					position568, tokenIndex568 := position, tokenIndex
+					if !_rules[ruleArgName]() {
+						goto l568
 					}
-					depth--
-					add(rulePegText, position196)
+					goto l566
+				l568:
+					position, tokenIndex = position568, tokenIndex568
 				}
-				{
-					add(ruleAction25, position)
+				if !_rules[ruleExpr]() {
+					goto l566
 				}
-				depth--
-				add(ruleDecimal, position195)
+				add(rulePosArg, position567)
 			}
 			return true
-		l194:
-			position, tokenIndex, depth = position194, tokenIndex194, depth194
+		l566:
+			position, tokenIndex = position566, tokenIndex566
 			return false
 		},
-		/* 26 Integer <- <(<WholeNum> Action26)> */
+		/* 82 NamedArgs <- <(NamedArg (sp ',' sp NamedArg)*)> */
 		func() bool {
-			position200, tokenIndex200, depth200 := position, tokenIndex, depth
+			position569, tokenIndex569 := position, tokenIndex
 			{
-				position201 := position
-				depth++
-				{
-					position202 := position
-					depth++
-					{
-						position203 := position
-						depth++
-						{
-							position204, tokenIndex204, depth204 := position, tokenIndex, depth
-							if buffer[position] != rune('0') {
-								goto l205
-							}
-							position++
-							goto l204
-						l205:
-							position, tokenIndex, depth = position204, tokenIndex204, depth204
-							{
-								position206, tokenIndex206, depth206 := position, tokenIndex, depth
-								if buffer[position] != rune('-') {
-									goto l206
-								}
-								position++
-								goto l207
-							l206:
-								position, tokenIndex, depth = position206, tokenIndex206, depth206
-							}
-						l207:
-							if c := buffer[position]; c < rune('1') || c > rune('9') {
-								goto l200
-							}
-							position++
-						l208:
-							{
-								position209, tokenIndex209, depth209 := position, tokenIndex, depth
-								if !_rules[ruleDigit]() {
-									goto l209
-								}
-								goto l208
-							l209:
-								position, tokenIndex, depth = position209, tokenIndex209, depth209
-							}
-						}
-					l204:
-						depth--
-						add(ruleWholeNum, position203)
-					}
-					depth--
-					add(rulePegText, position202)
+				position570 := position
+				if !_rules[ruleNamedArg]() {
+					goto l569
 				}
+			l571:
 				{
-					add(ruleAction26, position)
+					position572, tokenIndex572 := position, tokenIndex
+					if !_rules[rulesp]() {
+						goto l572
+					}
+					if buffer[position] != rune(',') {
+						goto l572
+					}
+					position++
+					if !_rules[rulesp]() {
+						goto l572
+					}
+					if !_rules[ruleNamedArg]() {
+						goto l572
+					}
+					goto l571
+				l572:
+					position, tokenIndex = position572, tokenIndex572
 				}
-				depth--
-				add(ruleInteger, position201)
+				add(ruleNamedArgs, position570)
 			}
 			return true
-		l200:
-			position, tokenIndex, depth = position200, tokenIndex200, depth200
+		l569:
+			position, tokenIndex = position569, tokenIndex569
 			return false
 		},
-		/* 27 WholeNum <- <('0' / ('-'? [1-9] Digit*))> */
-		nil,
-		/* 28 Digit <- <[0-9]> */
+		/* 83 NamedArg <- <(Action101 ArgName sp Expr Action102)> */
 		func() bool {
-			position212, tokenIndex212, depth212 := position, tokenIndex, depth
+			position573, tokenIndex573 := position, tokenIndex
 			{
-				position213 := position
-				depth++
-				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l212
+				position574 := position
+				{
+					add(ruleAction101, position)
 				}
-				position++
-				depth--
-				add(ruleDigit, position213)
+				if !_rules[ruleArgName]() {
+					goto l573
+				}
+				if !_rules[rulesp]() {
+					goto l573
+				}
+				if !_rules[ruleExpr]() {
+					goto l573
+				}
+				{
+					add(ruleAction102, position)
+				}
+				add(ruleNamedArg, position574)
 			}
 			return true
-		l212:
-			position, tokenIndex, depth = position212, tokenIndex212, depth212
+		l573:
+			position, tokenIndex = position573, tokenIndex573
 			return false
 		},
-		/* 29 Boolean <- <(Action27 <(('t' 'r' 'u' 'e') / ('f' 'a' 'l' 's' 'e'))> Action28 Action29)> */
-		nil,
-		/* 30 Func <- <(Action30 FuncArgs sp ('-' '>') sp (Block / Expr) Action31)> */
-		nil,
-		/* 31 FuncArgs <- <(Action32 '(' sp (LocalRef (sp ',' sp LocalRef)* sp)? ')' Action33)> */
-		nil,
-		/* 32 FuncApply <- <(Action34 Ref Tuple Action35)> */
-		nil,
-		/* 33 List <- <(Action36 '[' sp (Expr (sp ',' sp Expr)* sp)? ']' Action37)> */
-		nil,
-		/* 34 Tuple <- <(Action38 '(' sp (Expr (sp ',' sp Expr)* sp)? ')' Action39)> */
+		/* 84 ArgName <- <(<Name> Action103 sp ':' !RefChar)> */
 		func() bool {
-			position219, tokenIndex219, depth219 := position, tokenIndex, depth
+			position577, tokenIndex577 := position, tokenIndex
 			{
-				position220 := position
-				depth++
+				position578 := position
 				{
-					add(ruleAction38, position)
+					position579 := position
+					if !_rules[ruleName]() {
+						goto l577
+					}
+					add(rulePegText, position579)
 				}
-				if buffer[position] != rune('(') {
-					goto l219
+				{
+					add(ruleAction103, position)
 				}
-				position++
 				if !_rules[rulesp]() {
-					goto l219
-				}
-				{
-					position222, tokenIndex222, depth222 := position, tokenIndex, depth
-					if !_rules[ruleExpr]() {
-						goto l222
-					}
-				l224:
-					{
-						position225, tokenIndex225, depth225 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l225
-						}
-						if buffer[position] != rune(',') {
-							goto l225
-						}
-						position++
-						if !_rules[rulesp]() {
-							goto l225
-						}
-						if !_rules[ruleExpr]() {
-							goto l225
-						}
-						goto l224
-					l225:
-						position, tokenIndex, depth = position225, tokenIndex225, depth225
-					}
-					if !_rules[rulesp]() {
-						goto l222
-					}
-					goto l223
-				l222:
-					position, tokenIndex, depth = position222, tokenIndex222, depth222
+					goto l577
 				}
-			l223:
-				if buffer[position] != rune(')') {
-					goto l219
+				if buffer[position] != rune(':') {
+					goto l577
 				}
 				position++
 				{
-					add(ruleAction39, position)
+					position581, tokenIndex581 := position, tokenIndex
+					if !_rules[ruleRefChar]() {
+						goto l581
+					}
+					goto l577
+				l581:
+					position, tokenIndex = position581, tokenIndex581
 				}
-				depth--
-				add(ruleTuple, position220)
+				add(ruleArgName, position578)
 			}
 			return true
-		l219:
-			position, tokenIndex, depth = position219, tokenIndex219, depth219
+		l577:
+			position, tokenIndex = position577, tokenIndex577
 			return false
 		},
-		/* 35 Map <- <(Action40 '{' sp (Expr sp ':' sp Expr (sp ',' sp Expr sp ':' sp Expr)* sp)? '}' Action41)> */
+		/* 85 List <- <(Action104 '[' sp (Expr (sp ',' sp Expr)* sp)? ']' Action105)> */
+		nil,
+		/* 86 Tuple <- <(Action106 '(' sp (Expr (sp ',' sp Expr)* sp)? ')' Action107)> */
 		nil,
-		/* 36 Gravitasse <- <'@'> */
+		/* 87 Map <- <(Action108 '{' sp (Expr sp ':' sp Expr (sp ',' sp Expr sp ':' sp Expr)* sp)? '}' Action109)> */
 		nil,
-		/* 37 msp <- <(ws / comment)+> */
+		/* 88 Gravitasse <- <'@'> */
 		nil,
-		/* 38 sp <- <(ws / comment)*> */
+		/* 89 msp <- <(ws / comment)+> */
+		nil,
+		/* 90 sp <- <(ws / comment)*> */
 		func() bool {
 			{
-				position231 := position
-				depth++
-			l232:
+				position588 := position
+			l589:
 				{
-					position233, tokenIndex233, depth233 := position, tokenIndex, depth
+					position590, tokenIndex590 := position, tokenIndex
 					{
-						position234, tokenIndex234, depth234 := position, tokenIndex, depth
+						position591, tokenIndex591 := position, tokenIndex
 						if !_rules[rulews]() {
-							goto l235
+							goto l592
 						}
-						goto l234
-					l235:
-						position, tokenIndex, depth = position234, tokenIndex234, depth234
+						goto l591
+					l592:
+						position, tokenIndex = position591, tokenIndex591
 						if !_rules[rulecomment]() {
-							goto l233
+							goto l590
 						}
 					}
-				l234:
-					goto l232
-				l233:
-					position, tokenIndex, depth = position233, tokenIndex233, depth233
+				l591:
+					goto l589
+				l590:
+					position, tokenIndex = position590, tokenIndex590
 				}
-				depth--
-				add(rulesp, position231)
+				add(rulesp, position588)
 			}
 			return true
 		},
-		/* 39 comment <- <('#' (!'\n' .)*)> */
+		/* 91 comment <- <('#' (!'\n' .)*)> */
 		func() bool {
-			position236, tokenIndex236, depth236 := position, tokenIndex, depth
+			position593, tokenIndex593 := position, tokenIndex
 			{
-				position237 := position
-				depth++
+				position594 := position
 				if buffer[position] != rune('#') {
-					goto l236
+					goto l593
 				}
 				position++
-			l238:
+			l595:
 				{
-					position239, tokenIndex239, depth239 := position, tokenIndex, depth
+					position596, tokenIndex596 := position, tokenIndex
 					{
-						position240, tokenIndex240, depth240 := position, tokenIndex, depth
+						position597, tokenIndex597 := position, tokenIndex
 						if buffer[position] != rune('\n') {
-							goto l240
+							goto l597
 						}
 						position++
-						goto l239
-					l240:
-						position, tokenIndex, depth = position240, tokenIndex240, depth240
+						goto l596
+					l597:
+						position, tokenIndex = position597, tokenIndex597
 					}
 					if !matchDot() {
-						goto l239
+						goto l596
 					}
-					goto l238
-				l239:
-					position, tokenIndex, depth = position239, tokenIndex239, depth239
+					goto l595
+				l596:
+					position, tokenIndex = position596, tokenIndex596
 				}
-				depth--
-				add(rulecomment, position237)
+				add(rulecomment, position594)
 			}
 			return true
-		l236:
-			position, tokenIndex, depth = position236, tokenIndex236, depth236
+		l593:
+			position, tokenIndex = position593, tokenIndex593
 			return false
 		},
-		/* 40 ws <- <((&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' '))> */
+		/* 92 ws <- <((&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' '))> */
 		func() bool {
-			position241, tokenIndex241, depth241 := position, tokenIndex, depth
+			position598, tokenIndex598 := position, tokenIndex
 			{
-				position242 := position
-				depth++
+				position599 := position
 				{
 					switch buffer[position] {
 					case '\r':
 						if buffer[position] != rune('\r') {
-							goto l241
+							goto l598
 						}
 						position++
-						break
 					case '\n':
 						if buffer[position] != rune('\n') {
-							goto l241
+							goto l598
 						}
 						position++
-						break
 					case '\t':
 						if buffer[position] != rune('\t') {
-							goto l241
+							goto l598
 						}
 						position++
-						break
 					default:
 						if buffer[position] != rune(' ') {
-							goto l241
+							goto l598
 						}
 						position++
-						break
 					}
 				}
 
-				depth--
-				add(rulews, position242)
+				add(rulews, position599)
 			}
 			return true
-		l241:
-			position, tokenIndex, depth = position241, tokenIndex241, depth241
+		l598:
+			position, tokenIndex = position598, tokenIndex598
 			return false
 		},
-		/* 42 Action0 <- <{ p.Start(RIFT) }> */
+		/* 94 Action0 <- <{ p.Start(RIFT) }> */
+		nil,
+		/* 95 Action1 <- <{ p.End() }> */
+		nil,
+		/* 96 Action2 <- <{ p.Start(BLOCK) }> */
+		nil,
+		/* 97 Action3 <- <{ p.End() }> */
+		nil,
+		/* 98 Action4 <- <{ p.Wrap(PROPAGATE) }> */
+		nil,
 		nil,
-		/* 43 Action1 <- <{ p.End() }> */
+		/* 100 Action5 <- <{ p.Wrap(FIELD, text) }> */
 		nil,
-		/* 44 Action2 <- <{ p.Start(BLOCK) }> */
+		/* 101 Action6 <- <{ p.StartWrap(WITH) }> */
 		nil,
-		/* 45 Action3 <- <{ p.End() }> */
+		/* 102 Action7 <- <{ p.End() }> */
 		nil,
-		/* 46 Action4 <- <{ p.Start(OP) }> */
+		/* 103 Action8 <- <{ p.Start(RECORD) }> */
 		nil,
-		/* 47 Action5 <- <{ p.End() }> */
+		/* 104 Action9 <- <{ p.End() }> */
 		nil,
-		/* 48 Action6 <- <{ p.Start(BINOP) }> */
+		/* 105 Action10 <- <{ p.Emit(text) }> */
 		nil,
+		/* 106 Action11 <- <{ p.Start(OP) }> */
 		nil,
-		/* 50 Action7 <- <{ p.Emit(string(buffer[begin:end])) }> */
+		/* 107 Action12 <- <{ p.End() }> */
 		nil,
-		/* 51 Action8 <- <{ p.End() }> */
+		/* 108 Action13 <- <{ p.Start(BINOP) }> */
 		nil,
-		/* 52 Action9 <- <{ p.Start(ASSIGNMENT) }> */
+		/* 109 Action14 <- <{ p.Emit(text) }> */
 		nil,
-		/* 53 Action10 <- <{ p.End() }> */
+		/* 110 Action15 <- <{ p.End() }> */
 		nil,
-		/* 54 Action11 <- <{ p.Start(IF) }> */
+		/* 111 Action16 <- <{ p.Start(ASSIGNMENT) }> */
 		nil,
-		/* 55 Action12 <- <{ p.End() }> */
+		/* 112 Action17 <- <{ p.End() }> */
 		nil,
-		/* 56 Action13 <- <{ p.Start(REF) }> */
+		/* 113 Action18 <- <{ p.Start(IF) }> */
 		nil,
-		/* 57 Action14 <- <{ p.Emit(string(buffer[begin:end])) }> */
+		/* 114 Action19 <- <{ p.End() }> */
 		nil,
-		/* 58 Action15 <- <{ p.Emit(string(buffer[begin:end])) }> */
+		/* 115 Action20 <- <{ p.Start(FOR) }> */
 		nil,
-		/* 59 Action16 <- <{ p.End() }> */
+		/* 116 Action21 <- <{ p.End() }> */
 		nil,
-		/* 60 Action17 <- <{ p.Start(REF) }> */
+		/* 117 Action22 <- <{ p.Start(WHILE) }> */
 		nil,
-		/* 61 Action18 <- <{ p.Emit(string(buffer[begin:end])) }> */
+		/* 118 Action23 <- <{ p.End() }> */
 		nil,
-		/* 62 Action19 <- <{ p.End() }> */
+		/* 119 Action24 <- <{ p.Start(BREAK) }> */
 		nil,
-		/* 63 Action20 <- <{ p.Start(STRING) }> */
+		/* 120 Action25 <- <{ p.End() }> */
 		nil,
-		/* 64 Action21 <- <{ p.Emit(string(buffer[begin:end])) }> */
+		/* 121 Action26 <- <{ p.Start(CONTINUE) }> */
 		nil,
-		/* 65 Action22 <- <{ p.End() }> */
+		/* 122 Action27 <- <{ p.End() }> */
 		nil,
-		/* 66 Action23 <- <{ p.Start(NUM) }> */
+		/* 123 Action28 <- <{ p.Start(TRY) }> */
 		nil,
-		/* 67 Action24 <- <{ p.End() }> */
+		/* 124 Action29 <- <{ p.End() }> */
 		nil,
-		/* 68 Action25 <- <{ p.Emit(string(buffer[begin:end])) }> */
+		/* 125 Action30 <- <{ p.Start(CATCH) }> */
 		nil,
-		/* 69 Action26 <- <{ p.Emit(string(buffer[begin:end])) }> */
+		/* 126 Action31 <- <{ p.End() }> */
 		nil,
-		/* 70 Action27 <- <{ p.Start(BOOL) }> */
+		/* 127 Action32 <- <{ p.Start(FINALLY) }> */
 		nil,
-		/* 71 Action28 <- <{ p.Emit(string(buffer[begin:end])) }> */
+		/* 128 Action33 <- <{ p.End() }> */
 		nil,
-		/* 72 Action29 <- <{ p.End() }> */
+		/* 129 Action34 <- <{ p.Start(MATCH) }> */
 		nil,
-		/* 73 Action30 <- <{ p.Start(FUNC) }> */
+		/* 130 Action35 <- <{ p.StartMatch(begin) }> */
 		nil,
-		/* 74 Action31 <- <{ p.End() }> */
+		/* 131 Action36 <- <{ p.EndMatch() }> */
 		nil,
-		/* 75 Action32 <- <{ p.Start(ARGS) }> */
+		/* 132 Action37 <- <{ p.End() }> */
 		nil,
-		/* 76 Action33 <- <{ p.End() }> */
+		/* 133 Action38 <- <{ p.Start(CASE) }> */
 		nil,
-		/* 77 Action34 <- <{ p.Start(FUNCAPPLY) }> */
+		/* 134 Action39 <- <{ p.End() }> */
 		nil,
-		/* 78 Action35 <- <{ p.End() }> */
+		/* 135 Action40 <- <{ p.Start(GUARD) }> */
 		nil,
-		/* 79 Action36 <- <{ p.Start(LIST) }> */
+		/* 136 Action41 <- <{ p.End() }> */
 		nil,
-		/* 80 Action37 <- <{ p.End() }> */
+		/* 137 Action42 <- <{ p.Start(WILDCARD) }> */
 		nil,
-		/* 81 Action38 <- <{ p.Start(TUPLE) }> */
+		/* 138 Action43 <- <{ p.End() }> */
 		nil,
-		/* 82 Action39 <- <{ p.End() }> */
+		/* 139 Action44 <- <{ p.Start(LIST_PATTERN) }> */
 		nil,
-		/* 83 Action40 <- <{ p.Start("map") }> */
+		/* 140 Action45 <- <{ p.End() }> */
 		nil,
-		/* 84 Action41 <- <{ p.End() }> */
+		/* 141 Action46 <- <{ p.Start(TUPLE_PATTERN) }> */
+		nil,
+		/* 142 Action47 <- <{ p.End() }> */
+		nil,
+		/* 143 Action48 <- <{ p.Start(REST) }> */
+		nil,
+		/* 144 Action49 <- <{ p.End() }> */
+		nil,
+		/* 145 Action50 <- <{ p.Start(MAP_PATTERN) }> */
+		nil,
+		/* 146 Action51 <- <{ p.End() }> */
+		nil,
+		/* 147 Action52 <- <{ p.Start(ENTRY) }> */
+		nil,
+		/* 148 Action53 <- <{ p.End() }> */
+		nil,
+		/* 149 Action54 <- <{ p.Start(REF) }> */
+		nil,
+		/* 150 Action55 <- <{ p.Emit(text) }> */
+		nil,
+		/* 151 Action56 <- <{ p.Emit(text) }> */
+		nil,
+		/* 152 Action57 <- <{ p.End() }> */
+		nil,
+		/* 153 Action58 <- <{ p.Start(REF) }> */
+		nil,
+		/* 154 Action59 <- <{ p.Emit(text) }> */
+		nil,
+		/* 155 Action60 <- <{ p.End() }> */
+		nil,
+		/* 156 Action61 <- <{ p.Start(REF) }> */
+		nil,
+		/* 157 Action62 <- <{ p.Emit(text) }> */
+		nil,
+		/* 158 Action63 <- <{ p.End() }> */
+		nil,
+		/* 159 Action64 <- <{ p.Start(STRING) }> */
+		nil,
+		/* 160 Action65 <- <{ p.EmitString(text, begin) }> */
+		nil,
+		/* 161 Action66 <- <{ p.End() }> */
+		nil,
+		/* 162 Action67 <- <{ p.Start(INTERPOLATION) }> */
+		nil,
+		/* 163 Action68 <- <{ p.End() }> */
+		nil,
+		/* 164 Action69 <- <{ p.Start(STRING) }> */
+		nil,
+		/* 165 Action70 <- <{ p.EmitString(text, begin) }> */
+		nil,
+		/* 166 Action71 <- <{ p.End() }> */
+		nil,
+		/* 167 Action72 <- <{ p.Start(FORMAT) }> */
+		nil,
+		/* 168 Action73 <- <{ p.EmitFormat(text, begin) }> */
+		nil,
+		/* 169 Action74 <- <{ p.End() }> */
+		nil,
+		/* 170 Action75 <- <{ p.InvalidInterpolation(text, begin) }> */
+		nil,
+		/* 171 Action76 <- <{ p.Start(STRING) }> */
+		nil,
+		/* 172 Action77 <- <{ p.EmitString(text, begin) }> */
+		nil,
+		/* 173 Action78 <- <{ p.End() }> */
+		nil,
+		/* 174 Action79 <- <{ p.Start(RAW_STRING) }> */
+		nil,
+		/* 175 Action80 <- <{ p.Emit(text) }> */
+		nil,
+		/* 176 Action81 <- <{ p.End() }> */
+		nil,
+		/* 177 Action82 <- <{ p.Start(BYTES) }> */
+		nil,
+		/* 178 Action83 <- <{ p.EmitBytes(text, begin) }> */
+		nil,
+		/* 179 Action84 <- <{ p.End() }> */
+		nil,
+		/* 180 Action85 <- <{ p.Start(NUM) }> */
+		nil,
+		/* 181 Action86 <- <{ p.Emit(text) }> */
+		nil,
+		/* 182 Action87 <- <{ p.End() }> */
+		nil,
+		/* 183 Action88 <- <{ p.Start(BOOL) }> */
+		nil,
+		/* 184 Action89 <- <{ p.Emit(text) }> */
+		nil,
+		/* 185 Action90 <- <{ p.End() }> */
+		nil,
+		/* 186 Action91 <- <{ p.Start(FUNC) }> */
+		nil,
+		/* 187 Action92 <- <{ p.End() }> */
+		nil,
+		/* 188 Action93 <- <{ p.Start(ARGS) }> */
+		nil,
+		/* 189 Action94 <- <{ p.End() }> */
+		nil,
+		/* 190 Action95 <- <{ p.Start(DEFAULT) }> */
+		nil,
+		/* 191 Action96 <- <{ p.End() }> */
+		nil,
+		/* 192 Action97 <- <{ p.Start(FUNCAPPLY) }> */
+		nil,
+		/* 193 Action98 <- <{ p.End() }> */
+		nil,
+		/* 194 Action99 <- <{ p.Start(TUPLE) }> */
+		nil,
+		/* 195 Action100 <- <{ p.End() }> */
+		nil,
+		/* 196 Action101 <- <{ p.Start(NAMED) }> */
+		nil,
+		/* 197 Action102 <- <{ p.End() }> */
+		nil,
+		/* 198 Action103 <- <{ p.Emit(text) }> */
+		nil,
+		/* 199 Action104 <- <{ p.Start(LIST) }> */
+		nil,
+		/* 200 Action105 <- <{ p.End() }> */
+		nil,
+		/* 201 Action106 <- <{ p.Start(TUPLE) }> */
+		nil,
+		/* 202 Action107 <- <{ p.End() }> */
+		nil,
+		/* 203 Action108 <- <{ p.Start(MAP) }> */
+		nil,
+		/* 204 Action109 <- <{ p.End() }> */
 		nil,
 	}
 	p.rules = _rules
+	return nil
 }
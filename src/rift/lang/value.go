@@ -3,52 +3,108 @@ package lang
 import (
 	"bytes"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 	"rift/support/sanity"
 )
 
+// The verbs allowed to end a format in an interpolation, as in "${x:.2f}"
+const FORMAT_VERBS = "vsqdxXobcfFeEgG"
+
+// Raw strings skip escape processing entirely
 func (stringNode *Node) Str() string {
-	sanity.Ensure(stringNode.Type == STRING, "Invalid cast from type [%s] to [%s]", stringNode.Type, STRING)
-	origStr := stringNode.Values[0].(string)
+	sanity.Ensure(stringNode.Type == STRING || stringNode.Type == RAW_STRING, "Invalid cast from type [%s] to [%s]", stringNode.Type, STRING)
+	if stringNode.Type == RAW_STRING {
+		return stringNode.Values[0].(string)
+	}
+	unescaped, _ := unescape(stringNode.Values[0].(string), false)
+	return string(unescaped)
+}
+
+func (bytesNode *Node) Bytes() []byte {
+	sanity.Ensure(bytesNode.Type == BYTES, "Invalid cast from type [%s] to [%s]", bytesNode.Type, BYTES)
+	unescaped, _ := unescape(bytesNode.Values[0].(string), true)
+	return unescaped
+}
 
+type invalidEscape struct{
+	offset int
+	escape string
+}
+
+var hexEscapeLengths = map[rune]int{'x': 2, 'u': 4, 'U': 8}
+
+// In strings \x, \u and \U give the Unicode code point with that value, while in
+// byte strings only \x is allowed and gives a single raw byte. Offsets of bad
+// escapes count runes from the start of the literal.
+func unescape(origStr string, binary bool) ([]byte, *invalidEscape) {
 	var buffer bytes.Buffer
-	var escaped bool
-	for _, c := range origStr {
-		if escaped {
-			switch c {
-			case '\\':
-				buffer.WriteRune('\\')
-			case '\'':
-				buffer.WriteRune('\'')
-			case '?':
-				buffer.WriteRune('?')
-			case '"':
-				buffer.WriteRune('"')
-			case 'a':
-				buffer.WriteRune('\a')
-			case 'b':
-				buffer.WriteRune('\b')
-			case 'f':
-				buffer.WriteRune('\f')
-			case 'n':
-				buffer.WriteRune('\n')
-			case 'r':
-				buffer.WriteRune('\r')
-			case 't':
-				buffer.WriteRune('\t')
-			case 'v':
-				buffer.WriteRune('\v')
-			}
-			escaped = false
+	chars := []rune(origStr)
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		if c != '\\' {
+			buffer.WriteRune(c)
 			continue
 		}
-		if c == '\\' {
-			escaped = true
-		} else {
-			buffer.WriteRune(c)
+
+		start := i
+		i++
+		switch chars[i] {
+		default:
+			return nil, &invalidEscape{start, string(chars[start:i+1])}
+		case '\\':
+			buffer.WriteRune('\\')
+		case '\'':
+			buffer.WriteRune('\'')
+		case '?':
+			buffer.WriteRune('?')
+		case '$':
+			buffer.WriteRune('$')
+		case '"':
+			buffer.WriteRune('"')
+		case '0':
+			buffer.WriteByte(0)
+		case 'a':
+			buffer.WriteRune('\a')
+		case 'b':
+			buffer.WriteRune('\b')
+		case 'f':
+			buffer.WriteRune('\f')
+		case 'n':
+			buffer.WriteRune('\n')
+		case 'r':
+			buffer.WriteRune('\r')
+		case 't':
+			buffer.WriteRune('\t')
+		case 'v':
+			buffer.WriteRune('\v')
+		case 'x', 'u', 'U':
+			digits := hexEscapeLengths[chars[i]]
+			end := i + 1 + digits
+			if end > len(chars) {
+				end = len(chars)
+			}
+			value, parseErr := strconv.ParseUint(string(chars[i+1:end]), 16, 32)
+			switch {
+			case parseErr != nil || end - i - 1 != digits || (binary && chars[i] != 'x'):
+				return nil, &invalidEscape{start, string(chars[start:end])}
+			case binary:
+				buffer.WriteByte(byte(value))
+			case !utf8.ValidRune(rune(value)):
+				return nil, &invalidEscape{start, string(chars[start:end])}
+			default:
+				buffer.WriteRune(rune(value))
+			}
+			i = end - 1
 		}
 	}
 
-	return buffer.String()
+	return buffer.Bytes(), nil
+}
+
+func (numericNode *Node) IsFloat() bool {
+	sanity.Ensure(numericNode.Type == NUM, "Invalid cast from type [%s] to [%s]", numericNode.Type, NUM)
+	return strings.ContainsAny(numericNode.Values[0].(string), ".eE")
 }
 
 func (numericNode *Node) Int() int {
@@ -59,6 +115,14 @@ func (numericNode *Node) Int() int {
 	return intValue
 }
 
+func (numericNode *Node) Float() float64 {
+	sanity.Ensure(numericNode.Type == NUM, "Invalid cast from type [%s] to [%s]", numericNode.Type, NUM)
+	floatAsString := numericNode.Values[0].(string)
+	floatValue, parseErr := strconv.ParseFloat(floatAsString, 64)
+	sanity.Ensure(parseErr == nil, "Invalid decimal value [%s]", floatAsString)
+	return floatValue
+}
+
 func (boolNode *Node) Bool() bool {
 	sanity.Ensure(boolNode.Type == BOOL, "Invalid cast from type [%s] to [%s]", boolNode.Type, BOOL)
 	boolAsString := boolNode.Values[0].(string)
@@ -0,0 +1,109 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+	"rift/support/sanity"
+)
+
+// Encodings are named explicitly when converting between strings and bytes,
+// and default to UTF-8
+func encodingArg(args []interface{}, i int) string {
+	if i >= len(args) {
+		return "utf-8"
+	}
+	encoding := strings.ToLower(ensureString(args[i]))
+	switch encoding {
+	default:
+		sanity.Fail("Unknown encoding [%s]", encoding)
+	case "utf-8", "utf8":
+		return "utf-8"
+	case "latin-1", "latin1", "iso-8859-1":
+		return "latin-1"
+	case "ascii":
+	}
+	return encoding
+}
+
+func bytesFromString(args []interface{}) interface{} {
+	s := ensureString(args[0])
+	encoding := encodingArg(args, 1)
+	if encoding == "utf-8" {
+		return Bytes(s)
+	}
+
+	encoded := Bytes{}
+	for _, c := range s {
+		if (encoding == "ascii" && c > 0x7f) || c > 0xff {
+			return raise(&Error{Kind: "encoding", Message: fmt.Sprintf("Can't encode [%c] as %s", c, encoding)})
+		}
+		encoded = append(encoded, byte(c))
+	}
+	return encoded
+}
+
+func bytesToString(args []interface{}) interface{} {
+	b := ensureBytes(args[0])
+	encoding := encodingArg(args, 1)
+	if encoding == "utf-8" {
+		if !utf8.Valid(b) {
+			return raise(&Error{Kind: "encoding", Message: "Bytes aren't valid utf-8"})
+		}
+		return string(b)
+	}
+
+	var decoded strings.Builder
+	for _, c := range b {
+		if encoding == "ascii" && c > 0x7f {
+			return raise(&Error{Kind: "encoding", Message: fmt.Sprintf("Byte [0x%02x] isn't valid ascii", c)})
+		}
+		decoded.WriteRune(rune(c))
+	}
+	return decoded.String()
+}
+
+// The end index is optional and exclusive
+func bytesSlice(args []interface{}) interface{} {
+	b := ensureBytes(args[0])
+	start, end := ensureInt(args[1]), len(b)
+	if len(args) == 3 {
+		end = ensureInt(args[2])
+	}
+	sanity.Ensure(start >= 0 && start <= end && end <= len(b), "Invalid range [%d, %d) for bytes of length [%d]", start, end, len(b))
+	return append(Bytes{}, b[start:end]...)
+}
+
+func bytesGet(args []interface{}) interface{} {
+	b := ensureBytes(args[0])
+	index := ensureInt(args[1])
+	sanity.Ensure(index >= 0 && index < len(b), "Index [%d] out of range for bytes of length [%d]", index, len(b))
+	return int(b[index])
+}
+
+// Takes any number of bytes or strings
+func bytesConcat(args []interface{}) interface{} {
+	concatenated := Bytes{}
+	for _, arg := range args {
+		concatenated = append(concatenated, ensureBytes(arg)...)
+	}
+	return concatenated
+}
+
+func bytesFromList(args []interface{}) interface{} {
+	b := Bytes{}
+	for _, value := range ensureList(args[0]) {
+		i := ensureInt(value)
+		sanity.Ensure(i >= 0 && i <= 0xff, "Byte values must be from [0] to [255], but got [%d]", i)
+		b = append(b, byte(i))
+	}
+	return b
+}
+
+func bytesToList(args []interface{}) interface{} {
+	list := List{}
+	for _, c := range ensureBytes(args[0]) {
+		list = append(list, int(c))
+	}
+	return list
+}
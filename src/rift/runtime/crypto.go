@@ -0,0 +1,89 @@
+package runtime
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	cryptorand "crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+)
+
+// Anything taking data accepts bytes or strings, and digests and decoded data
+// come back as bytes, so data that isn't valid UTF-8 comes out as it went in.
+
+var hashes = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+}
+
+func digestFunc(newHash func() hash.Hash) func([]interface{}) interface{} {
+	return func(args []interface{}) interface{} {
+		h := newHash()
+		h.Write(ensureBytes(args[0]))
+		return Bytes(h.Sum(nil))
+	}
+}
+
+// Takes the name of the hash to use, the key and the data
+func cryptoHMAC(args []interface{}) interface{} {
+	newHash, known := hashes[ensureString(args[0])]
+	if !known {
+		return raise(&Error{Kind: "crypto", Message: fmt.Sprintf("Unknown hash [%s]", args[0])})
+	}
+	mac := hmac.New(newHash, ensureBytes(args[1]))
+	mac.Write(ensureBytes(args[2]))
+	return Bytes(mac.Sum(nil))
+}
+
+// Takes as long to say no as it does to say yes, for comparing secrets
+func cryptoEqual(args []interface{}) interface{} {
+	return subtle.ConstantTimeCompare(ensureBytes(args[0]), ensureBytes(args[1])) == 1
+}
+
+// The optional second argument picks the "url" alphabet instead of "std"
+func base64Encoding(args []interface{}) *base64.Encoding {
+	if len(args) == 2 && ensureString(args[1]) == "url" {
+		return base64.URLEncoding
+	}
+	return base64.StdEncoding
+}
+
+func base64Encode(args []interface{}) interface{} {
+	return base64Encoding(args).EncodeToString(ensureBytes(args[0]))
+}
+
+func base64Decode(args []interface{}) interface{} {
+	decoded, err := base64Encoding(args).DecodeString(ensureString(args[0]))
+	if err != nil {
+		return raise(&Error{Kind: "decode", Message: err.Error()})
+	}
+	return Bytes(decoded)
+}
+
+func hexEncode(args []interface{}) interface{} {
+	return hex.EncodeToString(ensureBytes(args[0]))
+}
+
+func hexDecode(args []interface{}) interface{} {
+	decoded, err := hex.DecodeString(ensureString(args[0]))
+	if err != nil {
+		return raise(&Error{Kind: "decode", Message: err.Error()})
+	}
+	return Bytes(decoded)
+}
+
+func uuidV4(args []interface{}) interface{} {
+	var uuid [16]byte
+	if _, err := cryptorand.Read(uuid[:]); err != nil {
+		return raise(newError(err))
+	}
+	uuid[6] = (uuid[6] & 0x0f) | 0x40
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
+}
@@ -0,0 +1,102 @@
+package runtime
+
+import (
+	goruntime "runtime"
+	"strings"
+	"rift/support/sanity"
+)
+
+// Raised errors unwind as Go panics, picking up the Rift functions they pass
+// through on the way, until a try catches them. Gives back nothing, but can be
+// returned from so that raising reads like the rest of a builtin.
+func raise(err *Error) interface{} {
+	panic(err)
+}
+
+// Failed sanity checks are raised as errors of kind "runtime", so that they can
+// be caught like any other. Go's own runtime errors are bugs, so aren't caught.
+func asError(failure interface{}) (*Error, bool) {
+	switch f := failure.(type) {
+	case *Error:
+		return f, true
+	case goruntime.Error:
+		return nil, false
+	case error:
+		return &Error{Kind: "runtime", Message: strings.TrimPrefix(f.Error(), "Assertion failed: ")}, true
+	}
+	return nil, false
+}
+
+// Re-raises the failure with the frame it came through added to its stack
+func reraise(failure interface{}, frame string) {
+	if err, isErr := asError(failure); isErr {
+		err.Stack = append(err.Stack, frame)
+		panic(err)
+	}
+	panic(failure)
+}
+
+// Runs f, giving back the error it raised, if any
+func catch(f func() interface{}) (value interface{}, caught *Error) {
+	defer func() {
+		if failure := recover(); failure != nil {
+			err, isErr := asError(failure)
+			if !isErr {
+				panic(failure)
+			}
+			caught = err
+		}
+	}()
+	return f(), nil
+}
+
+// The error with where it was raised, innermost first
+func (e *Error) Trace() string {
+	lines := []string{e.String()}
+	for _, frame := range e.Stack {
+		lines = append(lines, "  at " + frame)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func ensureError(arg interface{}) *Error {
+	err, isErr := arg.(*Error)
+	sanity.Ensure(isErr, "Expected an error, but got [%v]", arg)
+	return err
+}
+
+// Takes the kind, the message and optionally any data to go with them
+func errorNew(args []interface{}) interface{} {
+	return &Error{Kind: ensureString(args[0]), Message: ensureString(args[1]), Data: optionalArg(args, 2)}
+}
+
+// Raises an error, or a string as the message of an error of kind "error". An
+// error raised again starts a new stack.
+func errorRaise(args []interface{}) interface{} {
+	if message, isString := args[0].(string); isString {
+		return raise(&Error{Kind: "error", Message: message})
+	}
+	raised := *ensureError(args[0])
+	raised.Stack = nil
+	return raise(&raised)
+}
+
+func errorKind(args []interface{}) interface{} {
+	return ensureError(args[0]).Kind
+}
+
+func errorMessage(args []interface{}) interface{} {
+	return ensureError(args[0]).Message
+}
+
+func errorData(args []interface{}) interface{} {
+	return ensureError(args[0]).Data
+}
+
+func errorStack(args []interface{}) interface{} {
+	stack := List{}
+	for _, frame := range ensureError(args[0]).Stack {
+		stack = append(stack, frame)
+	}
+	return stack
+}
@@ -0,0 +1,266 @@
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"rift/support/sanity"
+)
+
+// Files wrap anything we can read from or write to, including the standard
+// streams. Whichever of reader, writer, closer and seeker the underlying
+// stream doesn't support are nil. Reads from binary files give bytes rather
+// than strings.
+type File struct{
+	name   string
+	binary bool
+	reader *bufio.Reader
+	writer io.Writer
+	closer io.Closer
+	seeker io.Seeker
+}
+
+func newFile(file *os.File, binary bool) *File {
+	return &File{file.Name(), binary, bufio.NewReader(file), file, file, file}
+}
+
+func newInputStream(name string, reader io.Reader) *File {
+	return &File{name, false, bufio.NewReader(reader), nil, nil, nil}
+}
+
+func newOutputStream(name string, writer io.Writer) *File {
+	return &File{name, false, nil, writer, nil, nil}
+}
+
+// Copies what was read, since readers like bufio.Scanner reuse their buffers
+func (f *File) data(read []byte) interface{} {
+	if f.binary {
+		return append(Bytes{}, read...)
+	}
+	return string(read)
+}
+
+// The file's position runs ahead of the script's by whatever the reader has
+// buffered, so this moves it back to where the script has read up to
+func (f *File) unbuffer() error {
+	if f.seeker == nil || f.reader == nil || f.reader.Buffered() == 0 {
+		return nil
+	}
+	if _, err := f.seeker.Seek(-int64(f.reader.Buffered()), io.SeekCurrent); err != nil {
+		return err
+	}
+	f.reader.Reset(f.seeker.(io.Reader))
+	return nil
+}
+
+func (f *File) String() string {
+	return "file(" + f.name + ")"
+}
+
+func (f *File) unsupported(operation string) interface{} {
+	return raise(&Error{Kind: "io", Message: fmt.Sprintf("%s does not support %s", f, operation)})
+}
+
+// Any mode can have a "b" added to open the file as binary
+var fileModes = map[string]int{
+	"r":  os.O_RDONLY,
+	"w":  os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
+	"a":  os.O_WRONLY|os.O_CREATE|os.O_APPEND,
+	"r+": os.O_RDWR,
+}
+
+var seekOrigins = map[string]int{
+	"start":   io.SeekStart,
+	"current": io.SeekCurrent,
+	"end":     io.SeekEnd,
+}
+
+func fileArg(arg interface{}) *File {
+	f, isFile := arg.(*File)
+	sanity.Ensure(isFile, "Expected a file, but got [%v]", arg)
+	return f
+}
+
+func fileOpen(args []interface{}) interface{} {
+	filename := ensureString(args[0])
+	mode := ensureString(args[1])
+	binary := strings.Contains(mode, "b")
+	flags, validMode := fileModes[strings.Replace(mode, "b", "", 1)]
+	sanity.Ensure(validMode, "Invalid file mode [%s]", mode)
+	file, err := os.OpenFile(filename, flags, 0666)
+	if err != nil {
+		return raise(newError(err))
+	}
+	return newFile(file, binary)
+}
+
+func fileRead(args []interface{}) interface{} {
+	f := fileArg(args[0])
+	if f.reader == nil {
+		return f.unsupported("reading")
+	}
+	size := ensureInt(args[1])
+	sanity.Ensure(size >= 0, "Can't read [%d] bytes", size)
+	buffer := make([]byte, size)
+	n, err := io.ReadFull(f.reader, buffer)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return raise(newError(err))
+	}
+	return f.data(buffer[:n])
+}
+
+// Reads from stdin when not given a file
+func fileReadLine(args []interface{}) interface{} {
+	f := stdin
+	if len(args) == 1 {
+		f = fileArg(args[0])
+	}
+	if f.reader == nil {
+		return f.unsupported("reading")
+	}
+	line, err := f.reader.ReadBytes('\n')
+	if err == io.EOF && len(line) == 0 {
+		return nil
+	} else if err != nil && err != io.EOF {
+		return raise(newError(err))
+	}
+	return f.data(bytes.TrimRight(line, "\r\n"))
+}
+
+func fileReadAll(args []interface{}) interface{} {
+	f := fileArg(args[0])
+	if f.reader == nil {
+		return f.unsupported("reading")
+	}
+	data, err := ioutil.ReadAll(f.reader)
+	if err != nil {
+		return raise(newError(err))
+	}
+	return f.data(data)
+}
+
+// Takes either an open file or a path, in which case the file is opened and closed here
+func fileLines(args []interface{}) interface{} {
+	var f *File
+	if filename, isPath := args[0].(string); isPath {
+		file, err := os.Open(filename)
+		if err != nil {
+			return raise(newError(err))
+		}
+		defer file.Close()
+		f = newFile(file, false)
+	} else {
+		f = fileArg(args[0])
+		if f.reader == nil {
+			return f.unsupported("reading")
+		}
+	}
+
+	lines := List{}
+	scanner := bufio.NewScanner(f.reader)
+	for scanner.Scan() {
+		lines = append(lines, f.data(scanner.Bytes()))
+	}
+	if err := scanner.Err(); err != nil {
+		return raise(newError(err))
+	}
+	return lines
+}
+
+func fileWrite(args []interface{}) interface{} {
+	f := fileArg(args[0])
+	if f.writer == nil {
+		return f.unsupported("writing")
+	}
+	if err := f.unbuffer(); err != nil {
+		return raise(newError(err))
+	}
+	if _, err := f.writer.Write(ensureBytes(args[1])); err != nil {
+		return raise(newError(err))
+	}
+	return nil
+}
+
+func fileSeek(args []interface{}) interface{} {
+	f := fileArg(args[0])
+	if f.seeker == nil {
+		return f.unsupported("seeking")
+	}
+	origin := io.SeekStart
+	if len(args) == 3 {
+		var validOrigin bool
+		origin, validOrigin = seekOrigins[ensureString(args[2])]
+		sanity.Ensure(validOrigin, "Invalid seek origin [%s]", args[2])
+	}
+	if err := f.unbuffer(); err != nil {
+		return raise(newError(err))
+	}
+	offset, err := f.seeker.Seek(int64(ensureInt(args[1])), origin)
+	if err != nil {
+		return raise(newError(err))
+	}
+	// Anything buffered from before the seek is no longer where we are reading
+	// from. Only files are seekable, and those are always readable too.
+	f.reader.Reset(f.seeker.(io.Reader))
+	return int(offset)
+}
+
+func fileClose(args []interface{}) interface{} {
+	f := fileArg(args[0])
+	if f.closer == nil {
+		return f.unsupported("closing")
+	}
+	if err := f.closer.Close(); err != nil {
+		return raise(newError(err))
+	}
+	return nil
+}
+
+func fileStat(args []interface{}) interface{} {
+	info, err := os.Stat(ensureString(args[0]))
+	if err != nil {
+		return raise(newError(err))
+	}
+	return NewMap().
+		Put("name", info.Name()).
+		Put("size", int(info.Size())).
+		Put("mode", info.Mode().String()).
+		Put("is_dir", info.IsDir()).
+		Put("mod_time", int(info.ModTime().Unix()))
+}
+
+func fileExists(args []interface{}) interface{} {
+	_, err := os.Stat(ensureString(args[0]))
+	return err == nil
+}
+
+func fileRemove(args []interface{}) interface{} {
+	if err := os.Remove(ensureString(args[0])); err != nil {
+		return raise(newError(err))
+	}
+	return nil
+}
+
+func makeDir(args []interface{}) interface{} {
+	if err := os.MkdirAll(ensureString(args[0]), 0777); err != nil {
+		return raise(newError(err))
+	}
+	return nil
+}
+
+func listDir(args []interface{}) interface{} {
+	entries, err := ioutil.ReadDir(ensureString(args[0]))
+	if err != nil {
+		return raise(newError(err))
+	}
+	// ReadDir hands the entries back sorted by name
+	names := List{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
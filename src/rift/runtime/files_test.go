@@ -0,0 +1,66 @@
+package runtime
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Writes the contents to a file in a fresh directory, giving back its path
+func tempFile(t *testing.T, contents string) string {
+	dir, err := ioutil.TempDir("", "rift")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(path, []byte(contents), 0666); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestBinaryLines(t *testing.T) {
+	contents := ""
+	for i := 0; i < 2000; i++ {
+		contents += fmt.Sprintf("%04d\n", i)
+	}
+	path := tempFile(t, contents)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	f := fileOpen([]interface{}{path, "rb"})
+	defer fileClose([]interface{}{f})
+	lines := fileLines([]interface{}{f}).(List)
+	for i, line := range lines {
+		if expected := fmt.Sprintf("%04d", i); string(line.(Bytes)) != expected {
+			t.Fatalf("Expected line [%d] to be [%s], but got [%s]", i, expected, line)
+		}
+	}
+}
+
+func TestSeekAndWriteAfterReading(t *testing.T) {
+	path := tempFile(t, "first\nsecond\nthird\n")
+	defer os.RemoveAll(filepath.Dir(path))
+
+	f := fileOpen([]interface{}{path, "r+"})
+	if line := fileReadLine([]interface{}{f}); line != "first" {
+		t.Fatalf("Expected [first], but got [%v]", line)
+	}
+	if offset := fileSeek([]interface{}{f, 0, "current"}); offset != 6 {
+		t.Errorf("Expected to be at [6], but got [%v]", offset)
+	}
+	if line := fileReadLine([]interface{}{f}); line != "second" {
+		t.Fatalf("Expected [second], but got [%v]", line)
+	}
+	fileWrite([]interface{}{f, "T"})
+	fileClose([]interface{}{f})
+
+	written, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(written) != "first\nsecond\nThird\n" {
+		t.Errorf("Expected to write after the second line, but got [%s]", written)
+	}
+}
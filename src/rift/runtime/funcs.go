@@ -10,33 +10,231 @@ func ensureArity(expectedLength int, actualLength int) {
 	sanity.Ensure(actualLength == expectedLength, "Function expects [%d] arguments, but got [%d]", expectedLength, actualLength)
 }
 
+func ensureArityBetween(minLength int, maxLength int, actualLength int) {
+	sanity.Ensure(actualLength >= minLength && actualLength <= maxLength, "Function expects [%d] to [%d] arguments, but got [%d]", minLength, maxLength, actualLength)
+}
+
+func ensureArityAtLeast(minLength int, actualLength int) {
+	sanity.Ensure(actualLength >= minLength, "Function expects at least [%d] arguments, but got [%d]", minLength, actualLength)
+}
+
+func ensureString(arg interface{}) string {
+	s, isString := arg.(string)
+	sanity.Ensure(isString, "Expected a string, but got [%v]", arg)
+	return s
+}
+
+// Strings are taken as their UTF-8 encoding
+func ensureBytes(arg interface{}) []byte {
+	switch v := arg.(type) {
+	default:
+		sanity.Fail("Expected bytes or a string, but got [%v]", arg)
+		return nil
+	case Bytes:
+		return v
+	case string:
+		return []byte(v)
+	}
+}
+
+func ensureInt(arg interface{}) int {
+	i, isInt := arg.(int)
+	sanity.Ensure(isInt, "Expected an integer, but got [%v]", arg)
+	return i
+}
+
+func ensureList(arg interface{}) List {
+	l, isList := arg.(List)
+	sanity.Ensure(isList, "Expected a list, but got [%v]", arg)
+	return l
+}
+
+// Maps iterate over their entries
+func ensureIterable(arg interface{}) List {
+	switch v := arg.(type) {
+	default:
+		sanity.Fail("Expected a list, range or map, but got [%v]", arg)
+		return nil
+	case List:
+		return v
+	case Range:
+		return v.List()
+	case *Map:
+		return v.Entries()
+	}
+}
+
+func ensureMap(arg interface{}) *Map {
+	m, isMap := arg.(*Map)
+	sanity.Ensure(isMap, "Expected a map, but got [%v]", arg)
+	return m
+}
+
+// Record types construct records when called
+func ensureFunc(arg interface{}) func([]interface{}) interface{} {
+	if t, isType := arg.(*RecordType); isType {
+		return t.construct
+	}
+	f, isFunc := arg.(func([]interface{}) interface{})
+	sanity.Ensure(isFunc, "Expected a function, but got [%v]", arg)
+	return f
+}
+
+func ensureBool(arg interface{}) bool {
+	b, isBool := arg.(bool)
+	sanity.Ensure(isBool, "Expected a boolean, but got [%v]", arg)
+	return b
+}
+
+// Named arguments are passed after the rest, so that functions not expecting
+// them still see a plain list of arguments
+type namedArgs struct{
+	values *Map
+}
+
+// Gives back nil when there are no named arguments
+func splitNamedArgs(args []interface{}) ([]interface{}, *Map) {
+	if len(args) > 0 {
+		if named, isNamed := args[len(args) - 1].(namedArgs); isNamed {
+			return args[:len(args) - 1], named.values
+		}
+	}
+	return args, nil
+}
+
+func unqualified(name string) string {
+	return name
+}
+
+// Positional arguments fill parameters in order, with any left over going to
+// the rest parameter. Named arguments and then defaults fill the others.
+func bindArgs(rift *lang.Rift, env collections.PersistentMap, params []*lang.Node, args []interface{}) {
+	positional, named := splitNamedArgs(args)
+	if named == nil {
+		named = NewMap()
+	}
+
+	var rest *lang.Node
+	if len(params) > 0 && params[len(params) - 1].Type == lang.REST {
+		rest = params[len(params) - 1].Rest().Binding()
+		params = params[:len(params) - 1]
+	}
+	required := 0
+	names := make(map[string]bool)
+	for _, param := range params {
+		switch param.Type {
+		case lang.DEFAULT:
+			names[param.Default().Ref().String()] = true
+			continue
+		case lang.REF:
+			names[param.Ref().String()] = true
+		}
+		required++
+	}
+	for _, name := range named.Keys() {
+		sanity.Ensure(names[name.(string)], "Function has no parameter [%s]", name)
+	}
+
+	arityFailure := func() {
+		switch {
+		case rest != nil:
+			ensureArityAtLeast(required, len(positional))
+		case required == len(params):
+			ensureArity(required, len(positional))
+		default:
+			ensureArityBetween(required, len(params), len(positional))
+		}
+	}
+	if rest == nil && len(positional) > len(params) {
+		arityFailure()
+	}
+
+	for i, param := range params {
+		target := param
+		if param.Type == lang.DEFAULT {
+			target = param.Default().Target()
+		}
+		name := ""
+		if target.Type == lang.REF {
+			name = target.Ref().String()
+		}
+		value, isNamed := named.Get(name)
+		switch {
+		case i < len(positional):
+			sanity.Ensure(!isNamed, "Argument [%s] given both by position and by name", name)
+			value = positional[i]
+		case isNamed:
+		case param.Type == lang.DEFAULT:
+			value = evaluate(rift, env, param.Default().Value())
+		default:
+			// Parameters after a default can be missing with the arity still fine
+			if named.Len() == 0 {
+				arityFailure()
+			}
+			if name == "" {
+				sanity.Fail("Missing argument [%d]", i + 1)
+			}
+			sanity.Fail("Missing argument [%s]", name)
+		}
+		destructure(rift, env, target, value, unqualified)
+	}
+
+	if rest != nil {
+		extra := List{}
+		if len(positional) > len(params) {
+			extra = append(extra, positional[len(params):]...)
+		}
+		destructure(rift, env, rest, extra, unqualified)
+	}
+}
+
 func makeFunc(rift *lang.Rift, outerEnv collections.PersistentMap, f *lang.Func) func([]interface{}) interface{} {
 	env := collections.ExtendPersistentMap(outerEnv)
+	env.Set(inFunction{}, true)
 	return func(args []interface{}) interface{} {
-		ensureArity(len(f.Args()), len(args))
-		for i, argRef := range f.Args() {
-			env.Set(argRef.String(), args[i])
-		}
-		
-		var lastValue interface{}
-		for _, line := range f.Lines() {
-			lastValue = evaluate(rift, env, line)
-		}
-		return lastValue
+		bindArgs(rift, env, f.Args(), args)
+
+		return returnPropagated(func() interface{} {
+			return outsideLoop(evalLines(rift, env, f.Lines()))
+		})
 	}
 }
 
 func doFuncApply(rift *lang.Rift, env collections.PersistentMap, funcApply *lang.FuncApply) interface{} {
-	f := dereference(rift, env, funcApply.Ref()).(func([]interface{})interface{})
+	f := ensureFunc(dereference(rift, env, funcApply.Ref()))
 	args := funcApply.Args().Values()
 	var argValues []interface{}
+	named := NewMap()
 	for _, arg := range args {
-		argValue := evaluate(rift, env, arg.(*lang.Node))
+		argNode := arg.(*lang.Node)
+		if argNode.Type == lang.NAMED {
+			n := argNode.Named()
+			sanity.Ensure(!named.Has(n.Name()), "Argument [%s] given more than once", n.Name())
+			named = named.Put(n.Name(), evaluate(rift, env, n.Value()))
+			continue
+		}
+		argValue := evaluate(rift, env, argNode)
 		argValues = append(argValues, argValue)	
 	}
+	if named.Len() > 0 {
+		argValues = append(argValues, namedArgs{named})
+	}
+	// Whatever's raised in the call is raised again here, from this frame
 	returnValue := make(chan interface{}, 1)
+	failed := make(chan interface{}, 1)
 	go func() {
+		defer func() {
+			if failure := recover(); failure != nil {
+				failed <- failure
+			}
+		}()
 		returnValue <- f(argValues)
 	}()
-	return <-returnValue
+	select {
+	case value := <-returnValue:
+		return value
+	case failure := <-failed:
+		reraise(failure, funcApply.Ref().String())
+		return nil
+	}
 }
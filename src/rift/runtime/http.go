@@ -0,0 +1,129 @@
+package runtime
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const DEFAULT_HTTP_TIMEOUT = 30 * time.Second
+
+func headersArg(arg interface{}) http.Header {
+	headers := http.Header{}
+	if arg == nil {
+		return headers
+	}
+	for _, entry := range ensureMap(arg).Entries() {
+		pair := entry.(List)
+		headers.Add(toString(pair[0]), toString(pair[1]))
+	}
+	return headers
+}
+
+// Headers sent more than once are joined with commas, as HTTP allows
+func headersMap(headers http.Header) *Map {
+	var names []string
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	m := newMapBuilder()
+	for _, name := range names {
+		m.Put(name, strings.Join(headers[name], ", "))
+	}
+	return m.Map()
+}
+
+func doRequest(method string, url string, headers http.Header, body string, timeout time.Duration) interface{} {
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		return raise(&Error{Kind: "http", Message: err.Error()})
+	}
+	request.Header = headers
+
+	client := http.Client{Timeout: timeout}
+	response, err := client.Do(request)
+	if err != nil {
+		return raise(&Error{Kind: "http", Message: err.Error()})
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return raise(&Error{Kind: "http", Message: err.Error()})
+	}
+	return NewMap().
+		Put("status", response.StatusCode).
+		Put("headers", headersMap(response.Header)).
+		Put("body", string(responseBody))
+}
+
+func optionalArg(args []interface{}, i int) interface{} {
+	if i < len(args) {
+		return args[i]
+	}
+	return nil
+}
+
+// Headers are optional
+func httpGet(args []interface{}) interface{} {
+	return doRequest("GET", ensureString(args[0]), headersArg(optionalArg(args, 1)), "", DEFAULT_HTTP_TIMEOUT)
+}
+
+func httpPost(args []interface{}) interface{} {
+	return doRequest("POST", ensureString(args[0]), headersArg(optionalArg(args, 2)), ensureString(args[1]), DEFAULT_HTTP_TIMEOUT)
+}
+
+// Takes a map with the "url" and optionally the "method", "headers", "body"
+// and "timeout"
+func httpRequest(args []interface{}) interface{} {
+	opts := ensureMap(args[0])
+	url, _ := opts.Get("url")
+	method, hasMethod := opts.Get("method")
+	if !hasMethod {
+		method = "GET"
+	}
+	headers, _ := opts.Get("headers")
+	body, hasBody := opts.Get("body")
+	if !hasBody {
+		body = ""
+	}
+	timeout := DEFAULT_HTTP_TIMEOUT
+	if t, hasTimeout := opts.Get("timeout"); hasTimeout {
+		timeout = ensureDuration(t)
+	}
+	return doRequest(strings.ToUpper(ensureString(method)), ensureString(url), headersArg(headers), ensureString(body), timeout)
+}
+
+// Gives back the decoded body of a successful response
+func jsonResponse(response interface{}) interface{} {
+	m, isMap := response.(*Map)
+	if !isMap {
+		return response
+	}
+	status, _ := m.Get("status")
+	body, _ := m.Get("body")
+	if status.(int) < 200 || status.(int) > 299 {
+		return raise(&Error{Kind: "http", Message: fmt.Sprintf("Request failed with status [%d]: %s", status, body)})
+	}
+	return jsonParse([]interface{}{body})
+}
+
+func httpGetJSON(args []interface{}) interface{} {
+	headers := headersArg(optionalArg(args, 1))
+	headers.Set("Accept", "application/json")
+	return jsonResponse(doRequest("GET", ensureString(args[0]), headers, "", DEFAULT_HTTP_TIMEOUT))
+}
+
+// Sends the value encoded as JSON
+func httpPostJSON(args []interface{}) interface{} {
+	body := jsonStringify(args[1:2])
+	headers := headersArg(optionalArg(args, 2))
+	headers.Set("Accept", "application/json")
+	headers.Set("Content-Type", "application/json")
+	return jsonResponse(doRequest("POST", ensureString(args[0]), headers, body.(string), DEFAULT_HTTP_TIMEOUT))
+}
@@ -0,0 +1,208 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+	"rift/runtime/sys"
+	"rift/support/logging"
+)
+
+const SHUTDOWN_TIMEOUT = 10 * time.Second
+
+// Rift functions aren't safe to run concurrently, so a server only lets one
+// request into the handler at a time
+type riftHandler struct{
+	handler func([]interface{}) interface{}
+	lock    sync.Mutex
+}
+
+func (h *riftHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request, err := requestMap(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var response interface{}
+	panicked := func() (failed bool) {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		defer func() {
+			if failure := recover(); failure != nil {
+				logging.Warn("Handler failed for [%s %s]: %v", r.Method, r.URL.Path, failure)
+				failed = true
+			}
+		}()
+		response = h.handler([]interface{}{request})
+		return false
+	}()
+	if panicked {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	writeResponse(w, response)
+}
+
+func requestMap(r *http.Request) (*Map, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	query := newMapBuilder()
+	for name, values := range r.URL.Query() {
+		query.Put(name, values[0])
+	}
+	return NewMap().
+		Put("method", r.Method).
+		Put("path", r.URL.Path).
+		Put("query", query.Map()).
+		Put("headers", headersMap(r.Header)).
+		Put("body", string(body)).
+		Put("params", NewMap()).
+		Put("remote_addr", r.RemoteAddr), nil
+}
+
+// Handlers may give back just a body, or a map with the "status", "headers"
+// and "body". Bodies that aren't strings are sent as JSON, so maps meant as the
+// body itself need wrapping in {"body": ...}.
+func writeResponse(w http.ResponseWriter, response interface{}) {
+	status := http.StatusOK
+	body := response
+	if m, isMap := response.(*Map); isMap && (m.Has("status") || m.Has("body")) {
+		if s, hasStatus := m.Get("status"); hasStatus {
+			status = ensureInt(s)
+		}
+		if headers, hasHeaders := m.Get("headers"); hasHeaders {
+			for name, values := range headersArg(headers) {
+				w.Header()[name] = values
+			}
+		}
+		body, _ = m.Get("body")
+	}
+
+	switch b := body.(type) {
+	default:
+		var encoded bytes.Buffer
+		if err := encodeJSON(&encoded, b); err != nil {
+			http.Error(w, err.Message, http.StatusInternalServerError)
+			return
+		}
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		body = encoded.String()
+	case nil:
+		body = ""
+	case string:
+	}
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
+
+// Blocks until the server fails or the process is interrupted, at which point
+// in-flight requests are given a chance to finish
+func httpServe(args []interface{}) interface{} {
+	server := &http.Server{Addr: ensureString(args[0]), Handler: &riftHandler{handler: ensureFunc(args[1])}}
+
+	interrupted, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	failed := make(chan error, 1)
+	go func() {
+		failed <- server.ListenAndServe()
+	}()
+	fmt.Fprintf(sys.Stderr, "Serving on %s\n", server.Addr)
+
+	select {
+	case err := <-failed:
+		return raise(&Error{Kind: "http", Message: err.Error()})
+	case <-interrupted.Done():
+		fmt.Fprintf(sys.Stderr, "Shutting down %s\n", server.Addr)
+		shuttingDown, cancel := context.WithTimeout(context.Background(), SHUTDOWN_TIMEOUT)
+		defer cancel()
+		if err := server.Shutdown(shuttingDown); err != nil {
+			return raise(&Error{Kind: "http", Message: err.Error()})
+		}
+		return nil
+	}
+}
+
+type route struct{
+	method   string
+	segments []string
+	handler  func([]interface{}) interface{}
+}
+
+// Path segments starting with ':' match anything and are passed to the handler
+// in the request's "params". A method of "*" matches any method.
+func (rt *route) match(method string, path string) (*Map, bool) {
+	if rt.method != "*" && rt.method != method {
+		return nil, false
+	}
+	segments := splitPath(path)
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+	params := NewMap()
+	for i, segment := range rt.segments {
+		if strings.HasPrefix(segment, ":") {
+			params = params.Put(segment[1:], segments[i])
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// Takes a list of [method, path, handler] routes and gives back a handler that
+// sends each request to the first route matching it
+func httpRouter(args []interface{}) interface{} {
+	var routes []route
+	for _, r := range ensureList(args[0]) {
+		parts := ensureList(r)
+		ensureArity(3, len(parts))
+		routes = append(routes, route{strings.ToUpper(ensureString(parts[0])), splitPath(ensureString(parts[1])), ensureFunc(parts[2])})
+	}
+
+	return func(handlerArgs []interface{}) interface{} {
+		ensureArity(1, len(handlerArgs))
+		request := ensureMap(handlerArgs[0])
+		method, _ := request.Get("method")
+		path, _ := request.Get("path")
+		methodName, pathName := ensureString(method), ensureString(path)
+		for _, rt := range routes {
+			if params, matched := rt.match(methodName, pathName); matched {
+				return rt.handler([]interface{}{request.Put("params", params)})
+			}
+		}
+		return NewMap().Put("status", http.StatusNotFound).Put("body", "Not found")
+	}
+}
+
+// Wraps a handler in middleware, each of which is called with the request and
+// the next handler in line. The first middleware given runs first.
+func httpUse(args []interface{}) interface{} {
+	handler := ensureFunc(args[0])
+	middleware := ensureList(args[1])
+	for i := len(middleware) - 1; i >= 0; i-- {
+		wrap, next := ensureFunc(middleware[i]), handler
+		handler = func(handlerArgs []interface{}) interface{} {
+			ensureArity(1, len(handlerArgs))
+			return wrap([]interface{}{handlerArgs[0], next})
+		}
+	}
+	return handler
+}
@@ -0,0 +1,105 @@
+package runtime
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Echoes the request back, as JSON when it was sent as JSON
+func echoServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Greeting", r.Header.Get("X-Greeting"))
+		switch r.URL.Path {
+		default:
+			w.Write(body)
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("Not found"))
+		case "/json":
+			w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+			if r.Method == "GET" {
+				body = []byte(`{"ok": true, "ids": [1, 2]}`)
+			}
+			w.Write(body)
+		}
+	}))
+}
+
+func field(t *testing.T, value interface{}, key string) interface{} {
+	m, isMap := value.(*Map)
+	if !isMap {
+		t.Fatalf("Expected a map, but got [%v]", value)
+	}
+	found, _ := m.Get(key)
+	return found
+}
+
+func TestHttpGet(t *testing.T) {
+	server := echoServer()
+	defer server.Close()
+
+	response := httpGet([]interface{}{server.URL, NewMap().Put("X-Greeting", "hello")})
+	if status := field(t, response, "status"); status != 200 {
+		t.Errorf("Expected status [200], but got [%v]", status)
+	}
+	headers := field(t, response, "headers")
+	if method := field(t, headers, "X-Method"); method != "GET" {
+		t.Errorf("Expected method [GET], but got [%v]", method)
+	}
+	if greeting := field(t, headers, "X-Greeting"); greeting != "hello" {
+		t.Errorf("Expected header [hello], but got [%v]", greeting)
+	}
+}
+
+func TestHttpPost(t *testing.T) {
+	server := echoServer()
+	defer server.Close()
+
+	response := httpPost([]interface{}{server.URL, "posted"})
+	if method := field(t, field(t, response, "headers"), "X-Method"); method != "POST" {
+		t.Errorf("Expected method [POST], but got [%v]", method)
+	}
+	if body := field(t, response, "body"); body != "posted" {
+		t.Errorf("Expected body [posted], but got [%v]", body)
+	}
+}
+
+func TestHttpStatus(t *testing.T) {
+	server := echoServer()
+	defer server.Close()
+
+	response := httpRequest([]interface{}{NewMap().Put("url", server.URL + "/missing").Put("method", "delete")})
+	if status := field(t, response, "status"); status != 404 {
+		t.Errorf("Expected status [404], but got [%v]", status)
+	}
+	if method := field(t, field(t, response, "headers"), "X-Method"); method != "DELETE" {
+		t.Errorf("Expected method [DELETE], but got [%v]", method)
+	}
+
+	_, caught := catch(func() interface{} {
+		return httpGetJSON([]interface{}{server.URL + "/missing"})
+	})
+	if caught == nil || caught.Kind != "http" {
+		t.Errorf("Expected an http error, but got [%v]", caught)
+	}
+}
+
+func TestHttpJSON(t *testing.T) {
+	server := echoServer()
+	defer server.Close()
+
+	got := httpGetJSON([]interface{}{server.URL + "/json"})
+	expected := NewMap().Put("ok", true).Put("ids", List{1, 2})
+	if !valuesEqual(got, expected) {
+		t.Errorf("Expected [%v], but got [%v]", expected, got)
+	}
+
+	posted := NewMap().Put("name", "rift")
+	if got := httpPostJSON([]interface{}{server.URL + "/json", posted}); !valuesEqual(got, posted) {
+		t.Errorf("Expected [%v] echoed back, but got [%v]", posted, got)
+	}
+}
@@ -0,0 +1,184 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"rift/support/sanity"
+)
+
+// Objects keep the order their keys appear in, and whole numbers become integers
+func jsonParse(args []interface{}) interface{} {
+	source := ensureString(args[0])
+	decoder := json.NewDecoder(strings.NewReader(source))
+	decoder.UseNumber()
+
+	value, err := decodeJSON(decoder)
+	if err == nil {
+		if _, trailing := decoder.Token(); trailing != io.EOF {
+			err = fmt.Errorf("unexpected data after top-level value")
+		}
+	}
+	if err != nil {
+		offset := int(decoder.InputOffset())
+		if syntaxErr, isSyntaxErr := err.(*json.SyntaxError); isSyntaxErr {
+			offset = int(syntaxErr.Offset)
+		}
+		line, column := textPosition(source, offset)
+		return raise(&Error{Kind: "parse", Message: fmt.Sprintf("%s (line %d, character %d)", err, line, column)})
+	}
+	return value
+}
+
+func decodeJSON(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return nil, err
+	}
+
+	switch t := token.(type) {
+	default:
+		return t, nil
+	case json.Number:
+		if i, intErr := strconv.Atoi(t.String()); intErr == nil {
+			return i, nil
+		}
+		return t.Float64()
+	case json.Delim:
+		if t == '[' {
+			list := List{}
+			for decoder.More() {
+				value, err := decodeJSON(decoder)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, value)
+			}
+			_, err := decoder.Token()
+			return list, err
+		}
+
+		object := newMapBuilder()
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object.Put(key, value)
+		}
+		_, err := decoder.Token()
+		return object.Map(), err
+	}
+}
+
+// Lines and characters both count from 1
+func textPosition(text string, offset int) (int, int) {
+	if offset > len(text) {
+		offset = len(text)
+	}
+	preceding := text[:offset]
+	line := strings.Count(preceding, "\n") + 1
+	column := len([]rune(preceding[strings.LastIndex(preceding, "\n") + 1:])) + 1
+	return line, column
+}
+
+// Map keys are written in sorted order so the same value always gives the same
+// text. The indent is optional and is either a number of spaces or a string.
+func jsonStringify(args []interface{}) interface{} {
+	var buffer bytes.Buffer
+	if err := encodeJSON(&buffer, args[0]); err != nil {
+		return raise(err)
+	}
+	if len(args) == 1 {
+		return buffer.String()
+	}
+
+	var indent string
+	switch i := args[1].(type) {
+	default:
+		indent = ensureString(args[1])
+	case int:
+		sanity.Ensure(i >= 0, "Can't indent by [%d] spaces", i)
+		indent = strings.Repeat(" ", i)
+	}
+	var indented bytes.Buffer
+	json.Indent(&indented, buffer.Bytes(), "", indent)
+	return indented.String()
+}
+
+func encodeJSON(buffer *bytes.Buffer, value interface{}) *Error {
+	switch v := value.(type) {
+	default:
+		return &Error{Kind: "json", Message: fmt.Sprintf("Can't convert [%s] to JSON", toString(value))}
+	case nil:
+		buffer.WriteString("null")
+	case bool:
+		buffer.WriteString(strconv.FormatBool(v))
+	case int:
+		buffer.WriteString(strconv.Itoa(v))
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return &Error{Kind: "json", Message: fmt.Sprintf("Can't convert [%v] to JSON", v)}
+		}
+		buffer.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case string:
+		encodeJSONString(buffer, v)
+	case List:
+		buffer.WriteByte('[')
+		for i, element := range v {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := encodeJSON(buffer, element); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	case *Map:
+		keys := make(map[string]interface{})
+		var names []string
+		for _, key := range v.Keys() {
+			name := toString(key)
+			if clash, clashes := keys[name]; clashes {
+				return &Error{Kind: "json", Message: fmt.Sprintf("Keys [%s] and [%s] are both the JSON key [%s]", show(clash), show(key), name)}
+			}
+			keys[name] = key
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		buffer.WriteByte('{')
+		for i, name := range names {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			encodeJSONString(buffer, name)
+			buffer.WriteByte(':')
+			element, _ := v.Get(keys[name])
+			if err := encodeJSON(buffer, element); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	}
+	return nil
+}
+
+func encodeJSONString(buffer *bytes.Buffer, s string) {
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(s)
+	// The encoder always finishes with a newline
+	buffer.Truncate(buffer.Len() - 1)
+}
@@ -0,0 +1,180 @@
+package runtime
+
+import (
+	"sort"
+	"rift/support/sanity"
+)
+
+// Callbacks are plain Rift functions, so anything from makeFunc or Predefs works.
+// Maps can be passed wherever a list is read from, standing in for their entries.
+
+func listMap(args []interface{}) interface{} {
+	f := ensureFunc(args[1])
+	mapped := List{}
+	for _, value := range ensureIterable(args[0]) {
+		mapped = append(mapped, f([]interface{}{value}))
+	}
+	return mapped
+}
+
+func listFilter(args []interface{}) interface{} {
+	f := ensureFunc(args[1])
+	filtered := List{}
+	for _, value := range ensureIterable(args[0]) {
+		if ensureBool(f([]interface{}{value})) {
+			filtered = append(filtered, value)
+		}
+	}
+	return filtered
+}
+
+// Takes the list, the initial accumulator and a function of (accumulator, value)
+func listReduce(args []interface{}) interface{} {
+	accum := args[1]
+	f := ensureFunc(args[2])
+	for _, value := range ensureIterable(args[0]) {
+		accum = f([]interface{}{accum, value})
+	}
+	return accum
+}
+
+func listEach(args []interface{}) interface{} {
+	f := ensureFunc(args[1])
+	for _, value := range ensureIterable(args[0]) {
+		f([]interface{}{value})
+	}
+	return nil
+}
+
+// Counts from start up to, but not including, end
+func listRange(args []interface{}) interface{} {
+	start, end, step := ensureInt(args[0]), ensureInt(args[1]), 1
+	if len(args) == 3 {
+		step = ensureInt(args[2])
+	}
+	sanity.Ensure(step != 0, "Range step can't be [0]")
+	numbers := List{}
+	for i := start; (step > 0 && i < end) || (step < 0 && i > end); i += step {
+		numbers = append(numbers, i)
+	}
+	return numbers
+}
+
+// Stops at the end of the shortest list
+func listZip(args []interface{}) interface{} {
+	lhs, rhs := ensureIterable(args[0]), ensureIterable(args[1])
+	zipped := List{}
+	for i := 0; i < len(lhs) && i < len(rhs); i++ {
+		zipped = append(zipped, List{lhs[i], rhs[i]})
+	}
+	return zipped
+}
+
+// Only flattens one level of nesting
+func listFlatten(args []interface{}) interface{} {
+	flattened := List{}
+	for _, value := range ensureIterable(args[0]) {
+		if inner, isList := value.(List); isList {
+			flattened = append(flattened, inner...)
+		} else {
+			flattened = append(flattened, value)
+		}
+	}
+	return flattened
+}
+
+func listReverse(args []interface{}) interface{} {
+	list := ensureIterable(args[0])
+	reversed := make(List, len(list))
+	for i, value := range list {
+		reversed[len(list) - 1 - i] = value
+	}
+	return reversed
+}
+
+func listSort(args []interface{}) interface{} {
+	sorted := append(List{}, ensureIterable(args[0])...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compareValues(sorted[i], sorted[j]) < 0
+	})
+	return sorted
+}
+
+// The comparator is given (a, b) and returns whether a belongs before b
+func listSortBy(args []interface{}) interface{} {
+	f := ensureFunc(args[1])
+	sorted := append(List{}, ensureIterable(args[0])...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return ensureBool(f([]interface{}{sorted[i], sorted[j]}))
+	})
+	return sorted
+}
+
+func listAny(args []interface{}) interface{} {
+	f := ensureFunc(args[1])
+	for _, value := range ensureIterable(args[0]) {
+		if ensureBool(f([]interface{}{value})) {
+			return true
+		}
+	}
+	return false
+}
+
+func listAll(args []interface{}) interface{} {
+	f := ensureFunc(args[1])
+	for _, value := range ensureIterable(args[0]) {
+		if !ensureBool(f([]interface{}{value})) {
+			return false
+		}
+	}
+	return true
+}
+
+// Gives nil when nothing matches
+func listFind(args []interface{}) interface{} {
+	f := ensureFunc(args[1])
+	for _, value := range ensureIterable(args[0]) {
+		if ensureBool(f([]interface{}{value})) {
+			return value
+		}
+	}
+	return nil
+}
+
+func listTake(args []interface{}) interface{} {
+	list := ensureIterable(args[0])
+	n := clampIndex(ensureInt(args[1]), len(list))
+	return append(List{}, list[:n]...)
+}
+
+func listDrop(args []interface{}) interface{} {
+	list := ensureIterable(args[0])
+	n := clampIndex(ensureInt(args[1]), len(list))
+	return append(List{}, list[n:]...)
+}
+
+func clampIndex(index int, length int) int {
+	switch {
+	default:
+		return index
+	case index < 0:
+		return 0
+	case index > length:
+		return length
+	}
+}
+
+// Builds a map from each key the function gives back to the values that produced it
+func listGroupBy(args []interface{}) interface{} {
+	f := ensureFunc(args[1])
+	groups := newMapBuilder()
+	for _, value := range ensureIterable(args[0]) {
+		key := f([]interface{}{value})
+		group, _ := groups.Get(key)
+		if group == nil {
+			group = List{}
+		}
+		groups.Put(key, append(group.(List), value))
+	}
+	return groups.Map()
+}
@@ -0,0 +1,80 @@
+package runtime
+
+// Maps are never changed in place; put, delete and friends give back new maps
+
+// The default is optional, and nil is given back for missing keys without one
+func mapGet(args []interface{}) interface{} {
+	value, exists := ensureMap(args[0]).Get(args[1])
+	if !exists && len(args) == 3 {
+		return args[2]
+	}
+	return value
+}
+
+func mapPut(args []interface{}) interface{} {
+	return ensureMap(args[0]).Put(args[1], args[2])
+}
+
+func mapDelete(args []interface{}) interface{} {
+	return ensureMap(args[0]).Delete(args[1])
+}
+
+func mapHas(args []interface{}) interface{} {
+	return ensureMap(args[0]).Has(args[1])
+}
+
+func mapKeys(args []interface{}) interface{} {
+	return append(List{}, ensureMap(args[0]).Keys()...)
+}
+
+func mapValues(args []interface{}) interface{} {
+	m := ensureMap(args[0])
+	values := List{}
+	for _, key := range m.Keys() {
+		value, _ := m.Get(key)
+		values = append(values, value)
+	}
+	return values
+}
+
+func mapEntries(args []interface{}) interface{} {
+	return ensureMap(args[0]).Entries()
+}
+
+// Takes any number of maps, with later ones winning when keys clash
+func mapMerge(args []interface{}) interface{} {
+	merged := newMapBuilder()
+	for _, arg := range args {
+		m := ensureMap(arg)
+		for _, key := range m.Keys() {
+			value, _ := m.Get(key)
+			merged.Put(key, value)
+		}
+	}
+	return merged.Map()
+}
+
+func mapMapValues(args []interface{}) interface{} {
+	m := ensureMap(args[0])
+	f := ensureFunc(args[1])
+	mapped := newMapBuilder()
+	for _, key := range m.Keys() {
+		value, _ := m.Get(key)
+		mapped.Put(key, f([]interface{}{value}))
+	}
+	return mapped.Map()
+}
+
+// The predicate is given (key, value)
+func mapFilter(args []interface{}) interface{} {
+	m := ensureMap(args[0])
+	f := ensureFunc(args[1])
+	filtered := newMapBuilder()
+	for _, key := range m.Keys() {
+		value, _ := m.Get(key)
+		if ensureBool(f([]interface{}{key, value})) {
+			filtered.Put(key, value)
+		}
+	}
+	return filtered.Map()
+}
@@ -0,0 +1,340 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"rift/lang"
+	"rift/support/collections"
+	"rift/support/sanity"
+)
+
+// Each case is flattened into the tests its pattern makes on parts of the
+// value, which are then shared between cases in a decision tree, so that no
+// part of the value is tested twice.
+
+const (
+	testEquals = iota
+	testLength
+	testMinLength
+	testMap
+	testKey
+)
+
+// A step into a list by index, into the list left from an index on, or into a
+// map by key
+type step struct{
+	index int
+	rest  bool
+	key   interface{}
+}
+
+type path []step
+
+func (p path) String() string {
+	var steps []string
+	for _, s := range p {
+		switch {
+		case s.key != nil:
+			steps = append(steps, "{" + show(s.key) + "}")
+		case s.rest:
+			steps = append(steps, fmt.Sprintf("[%d:]", s.index))
+		default:
+			steps = append(steps, fmt.Sprintf("[%d]", s.index))
+		}
+	}
+	return strings.Join(steps, "")
+}
+
+func (p path) extend(s step) path {
+	return append(append(path{}, p...), s)
+}
+
+// Parts that aren't there are reported as such, rather than failing
+func (p path) resolve(value interface{}) (interface{}, bool) {
+	for _, s := range p {
+		switch v := value.(type) {
+		default:
+			return nil, false
+		case List:
+			if s.key != nil || s.index > len(v) || (!s.rest && s.index == len(v)) {
+				return nil, false
+			}
+			if s.rest {
+				value = append(List{}, v[s.index:]...)
+			} else {
+				value = v[s.index]
+			}
+		case *Map:
+			if s.key == nil {
+				return nil, false
+			}
+			var found bool
+			if value, found = v.Get(s.key); !found {
+				return nil, false
+			}
+		}
+	}
+	return value, true
+}
+
+type test struct{
+	at    path
+	kind  int
+	value interface{}
+	id    string
+}
+
+func newTest(at path, kind int, value interface{}) test {
+	return test{at, kind, value, fmt.Sprintf("%s %d %s", at, kind, show(value))}
+}
+
+func (t test) passes(subject interface{}) bool {
+	value, found := t.at.resolve(subject)
+	if !found {
+		return false
+	}
+	switch t.kind {
+	default:
+		return valuesEqual(value, t.value)
+	case testLength, testMinLength:
+		list, isList := value.(List)
+		if t.kind == testMinLength {
+			return isList && len(list) >= t.value.(int)
+		}
+		return isList && len(list) == t.value.(int)
+	case testMap:
+		_, isMap := value.(*Map)
+		return isMap
+	case testKey:
+		m, isMap := value.(*Map)
+		return isMap && m.Has(t.value)
+	}
+}
+
+// Tests come before those on the parts they check for, so the first to fail
+// says best what's wrong
+func (t test) mismatch(subject interface{}) string {
+	value, _ := t.at.resolve(subject)
+	at := ""
+	if len(t.at) > 0 {
+		at = " at " + t.at.String()
+	}
+	switch t.kind {
+	default:
+		return fmt.Sprintf("Expected [%s]%s when destructuring, but got [%s]", show(t.value), at, show(value))
+	case testLength, testMinLength:
+		list, isList := value.(List)
+		switch {
+		case !isList:
+			return fmt.Sprintf("Expected a list%s to destructure, but got [%s]", at, show(value))
+		case t.kind == testMinLength:
+			return fmt.Sprintf("Expected at least [%d] values%s to destructure, but got [%d]", t.value, at, len(list))
+		}
+		return fmt.Sprintf("Expected [%d] values%s to destructure, but got [%d]", t.value, at, len(list))
+	case testMap:
+		return fmt.Sprintf("Expected a map%s to destructure, but got [%s]", at, show(value))
+	case testKey:
+		return fmt.Sprintf("Missing key [%s]%s to destructure", show(t.value), at)
+	}
+}
+
+// Whether both tests passing on the same value is impossible
+func (t test) excludes(other test) bool {
+	if t.at.String() != other.at.String() {
+		return false
+	}
+	lengths := func(kind int) bool {
+		return kind == testLength || kind == testMinLength
+	}
+	switch {
+	case t.kind == testEquals && other.kind == testEquals:
+		return !valuesEqual(t.value, other.value)
+	case t.kind == testEquals || other.kind == testEquals:
+		return true
+	case t.kind == testLength && other.kind == testLength:
+		return t.value != other.value
+	case t.kind == testLength && other.kind == testMinLength:
+		return t.value.(int) < other.value.(int)
+	case t.kind == testMinLength && other.kind == testLength:
+		return other.value.(int) < t.value.(int)
+	}
+	return lengths(t.kind) != lengths(other.kind)
+}
+
+type binding struct{
+	name string
+	at   path
+}
+
+type matchCase struct{
+	tests    []test
+	bindings []binding
+	guard    *lang.Node
+	lines    []*lang.Node
+}
+
+// Leaves have a case and nothing to test. They only go on to the failure branch
+// when the case's guard doesn't hold.
+type decision struct{
+	test    *test
+	matched *matchCase
+	success *decision
+	failure *decision
+}
+
+func flatten(rift *lang.Rift, env collections.PersistentMap, pattern *lang.Node, at path, c *matchCase) {
+	switch pattern.Type {
+	default:
+		c.tests = append(c.tests, newTest(at, testEquals, evaluate(rift, env, pattern)))
+	case lang.WILDCARD:
+	case lang.REF:
+		c.bindings = append(c.bindings, binding{pattern.Ref().String(), at})
+	case lang.LIST_PATTERN, lang.TUPLE_PATTERN:
+		elements := pattern.Pattern().Elements()
+		length := len(elements)
+		if length > 0 && elements[length - 1].Type == lang.REST {
+			length -= 1
+			c.tests = append(c.tests, newTest(at, testMinLength, length))
+		} else {
+			c.tests = append(c.tests, newTest(at, testLength, length))
+		}
+		for i, element := range elements {
+			if element.Type == lang.REST {
+				sanity.Ensure(i == length, "A rest can only come last in a pattern")
+				flatten(rift, env, element.Rest().Binding(), at.extend(step{index: i, rest: true}), c)
+			} else {
+				flatten(rift, env, element, at.extend(step{index: i}), c)
+			}
+		}
+	case lang.MAP_PATTERN:
+		c.tests = append(c.tests, newTest(at, testMap, nil))
+		for _, element := range pattern.Pattern().Elements() {
+			entry := element.Entry()
+			key := entry.Key()
+			if keyNode, isNode := key.(*lang.Node); isNode {
+				key = evaluate(rift, env, keyNode)
+			}
+			c.tests = append(c.tests, newTest(at, testKey, key))
+			flatten(rift, env, entry.Pattern(), at.extend(step{key: key}), c)
+		}
+	}
+}
+
+// Cases keep their order in every branch, so the first case to match wins
+func buildDecision(cases []*matchCase) *decision {
+	if len(cases) == 0 {
+		return nil
+	}
+	first := cases[0]
+	if len(first.tests) == 0 {
+		d := &decision{matched: first}
+		if first.guard != nil {
+			d.failure = buildDecision(cases[1:])
+		}
+		return d
+	}
+
+	chosen := first.tests[0]
+	var succeeding, failing []*matchCase
+	for _, c := range cases {
+		remaining, excluded, shared := []test{}, false, false
+		for _, t := range c.tests {
+			switch {
+			case t.id == chosen.id:
+				shared = true
+			case t.excludes(chosen):
+				excluded = true
+			default:
+				remaining = append(remaining, t)
+			}
+		}
+		if !excluded {
+			succeeding = append(succeeding, &matchCase{remaining, c.bindings, c.guard, c.lines})
+		}
+		if !shared {
+			failing = append(failing, c)
+		}
+	}
+	return &decision{test: &chosen, success: buildDecision(succeeding), failure: buildDecision(failing)}
+}
+
+var compiledMatches = struct{
+	sync.Mutex
+	decisions map[*lang.Node]*decision
+}{decisions: make(map[*lang.Node]*decision)}
+
+// Patterns only hold constants, so each match is compiled once
+func compileMatch(rift *lang.Rift, env collections.PersistentMap, node *lang.Node) *decision {
+	compiledMatches.Lock()
+	defer compiledMatches.Unlock()
+	if d, compiled := compiledMatches.decisions[node]; compiled {
+		return d
+	}
+	var cases []*matchCase
+	for _, c := range node.Match().Cases() {
+		mc := &matchCase{guard: c.Guard(), lines: c.Lines()}
+		flatten(rift, env, c.Pattern(), path{}, mc)
+		cases = append(cases, mc)
+	}
+	d := buildDecision(cases)
+	compiledMatches.decisions[node] = d
+	return d
+}
+
+var compiledPatterns = struct{
+	sync.Mutex
+	cases map[*lang.Node]*matchCase
+}{cases: make(map[*lang.Node]*matchCase)}
+
+// Binds each name in the pattern under the name given back by named, replacing
+// whatever it was bound to before
+func destructure(rift *lang.Rift, env collections.PersistentMap, pattern *lang.Node, value interface{}, named func(string) string) {
+	if pattern.Type == lang.REF {
+		env.Replace(named(pattern.Ref().String()), value)
+		return
+	}
+
+	compiledPatterns.Lock()
+	c, compiled := compiledPatterns.cases[pattern]
+	if !compiled {
+		c = &matchCase{}
+		flatten(rift, env, pattern, path{}, c)
+		compiledPatterns.cases[pattern] = c
+	}
+	compiledPatterns.Unlock()
+
+	for _, t := range c.tests {
+		sanity.Ensure(t.passes(value), "%s", t.mismatch(value))
+	}
+	for _, b := range c.bindings {
+		bound, _ := b.at.resolve(value)
+		env.Replace(named(b.name), bound)
+	}
+}
+
+func doMatch(rift *lang.Rift, env collections.PersistentMap, node *lang.Node) interface{} {
+	subject := evaluate(rift, env, node.Match().Subject())
+	d := compileMatch(rift, env, node)
+	for d != nil {
+		if d.test != nil {
+			if d.test.passes(subject) {
+				d = d.success
+			} else {
+				d = d.failure
+			}
+			continue
+		}
+
+		for _, b := range d.matched.bindings {
+			value, _ := b.at.resolve(subject)
+			env.Replace(b.name, value)
+		}
+		if d.matched.guard == nil || ensureBool(evaluate(rift, env, d.matched.guard)) {
+			return evalLines(rift, env, d.matched.lines)
+		}
+		d = d.failure
+	}
+	sanity.Fail("No case matched [%s]", show(subject))
+	return nil
+}
@@ -1,14 +1,40 @@
 package runtime
 
 import (
+	cryptorand "crypto/rand"
 	"math"
+	"math/big"
+	"math/rand"
+	"time"
+	"rift/support/sanity"
 )
 
+// Integers stay integers, including for `/`, until a decimal gets involved
 func doMath(lhs interface{}, rhs interface{}, operator string) interface{} {
-	// TODO: Get to work for rationals and decimals too
-	lhsValue := lhs.(int)
-	rhsValue := rhs.(int)
+	switch operator {
+	case "==":
+		return valuesEqual(lhs, rhs)
+	case "<":
+		return compareValues(lhs, rhs) < 0
+	case ">":
+		return compareValues(lhs, rhs) > 0
+	case "<=":
+		return compareValues(lhs, rhs) <= 0
+	case ">=":
+		return compareValues(lhs, rhs) >= 0
+	case "..":
+		return Range{ensureInt(lhs), ensureInt(rhs)}
+	}
+
+	lhsInt, lhsIsInt := lhs.(int)
+	rhsInt, rhsIsInt := rhs.(int)
+	if lhsIsInt && rhsIsInt {
+		return doIntMath(lhsInt, rhsInt, operator)
+	}
+	return doFloatMath(ensureNumber(lhs), ensureNumber(rhs), operator)
+}
 
+func doIntMath(lhsValue int, rhsValue int, operator string) interface{} {
 	switch operator {
 	default:
 		return nil
@@ -19,20 +45,210 @@ func doMath(lhs interface{}, rhs interface{}, operator string) interface{} {
 	case "*":
 		return lhsValue * rhsValue
 	case "/":
+		sanity.Ensure(rhsValue != 0, "Division by zero")
 		return lhsValue / rhsValue
 	case "**":
-		return int64(math.Pow(float64(lhsValue), float64(rhsValue)))
+		if rhsValue < 0 {
+			return math.Pow(float64(lhsValue), float64(rhsValue))
+		}
+		return intPow(lhsValue, rhsValue)
 	case "%":
+		sanity.Ensure(rhsValue != 0, "Division by zero")
 		return lhsValue % rhsValue
-	case "<":
-		return lhsValue < rhsValue
-	case ">":
-		return lhsValue > rhsValue
-	case "<=":
-		return lhsValue <= rhsValue
-	case ">=":
-		return lhsValue >= rhsValue
-	case "==":
-		return lhsValue == rhsValue
 	}
-}
\ No newline at end of file
+}
+
+// Squares its way up to the exponent, failing rather than wrapping around
+func intPow(base int, exponent int) int {
+	result, squared := 1, base
+	for e := exponent; e > 0; e >>= 1 {
+		var fits bool
+		if e & 1 == 1 {
+			result, fits = multiplyInts(result, squared)
+			sanity.Ensure(fits, "[%d ** %d] is too big for an integer", base, exponent)
+		}
+		if e > 1 {
+			squared, fits = multiplyInts(squared, squared)
+			sanity.Ensure(fits, "[%d ** %d] is too big for an integer", base, exponent)
+		}
+	}
+	return result
+}
+
+// Gives back whether the product fits in an int
+func multiplyInts(a int, b int) (int, bool) {
+	product := a * b
+	if a == 0 {
+		return 0, true
+	}
+	return product, product / a == b && !(a == -1 && b == math.MinInt64)
+}
+
+func doFloatMath(lhsValue float64, rhsValue float64, operator string) interface{} {
+	switch operator {
+	default:
+		return nil
+	case "+":
+		return lhsValue + rhsValue
+	case "-":
+		return lhsValue - rhsValue
+	case "*":
+		return lhsValue * rhsValue
+	case "/":
+		return lhsValue / rhsValue
+	case "**":
+		return math.Pow(lhsValue, rhsValue)
+	case "%":
+		return math.Mod(lhsValue, rhsValue)
+	}
+}
+
+func ensureNumber(arg interface{}) float64 {
+	switch v := arg.(type) {
+	default:
+		sanity.Fail("Expected a number, but got [%v]", arg)
+		return 0
+	case int:
+		return float64(v)
+	case float64:
+		return v
+	}
+}
+
+// Applies f to ints as ints and to decimals as decimals
+func numeric(args []interface{}, ints func(int) interface{}, floats func(float64) interface{}) interface{} {
+	if i, isInt := args[0].(int); isInt {
+		return ints(i)
+	}
+	return floats(ensureNumber(args[0]))
+}
+
+func floatFunc(f func(float64) float64) func([]interface{}) interface{} {
+	return func(args []interface{}) interface{} {
+		return f(ensureNumber(args[0]))
+	}
+}
+
+// Rounding always gives back an integer, so fails on what can't be one
+func roundingFunc(f func(float64) float64) func([]interface{}) interface{} {
+	return func(args []interface{}) interface{} {
+		return numeric(args, func(i int) interface{} {
+			return i
+		}, func(f64 float64) interface{} {
+			rounded := f(f64)
+			sanity.Ensure(rounded >= math.MinInt64 && rounded < math.MaxInt64, "Can't round [%v] to an integer", f64)
+			return int(rounded)
+		})
+	}
+}
+
+func mathAbs(args []interface{}) interface{} {
+	return numeric(args, func(i int) interface{} {
+		if i < 0 {
+			return -i
+		}
+		return i
+	}, func(f float64) interface{} {
+		return math.Abs(f)
+	})
+}
+
+func mathMin(args []interface{}) interface{} {
+	min := args[0]
+	for _, arg := range args[1:] {
+		if compareValues(arg, min) < 0 {
+			min = arg
+		}
+	}
+	return min
+}
+
+func mathMax(args []interface{}) interface{} {
+	max := args[0]
+	for _, arg := range args[1:] {
+		if compareValues(arg, max) > 0 {
+			max = arg
+		}
+	}
+	return max
+}
+
+func mathClamp(args []interface{}) interface{} {
+	value, low, high := args[0], args[1], args[2]
+	switch {
+	default:
+		return value
+	case compareValues(value, low) < 0:
+		return low
+	case compareValues(value, high) > 0:
+		return high
+	}
+}
+
+// Always divides as decimals, even given two integers
+func mathDiv(args []interface{}) interface{} {
+	return ensureNumber(args[0]) / ensureNumber(args[1])
+}
+
+// Divides and rounds down to an integer, unlike `/` which truncates
+func mathIntDiv(args []interface{}) interface{} {
+	quotient := math.Floor(ensureNumber(args[0]) / ensureNumber(args[1]))
+	sanity.Ensure(!math.IsInf(quotient, 0) && !math.IsNaN(quotient), "Division by zero")
+	return int(quotient)
+}
+
+func mathIsNaN(args []interface{}) interface{} {
+	f, isFloat := args[0].(float64)
+	return isFloat && math.IsNaN(f)
+}
+
+var randSource = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// Makes every random number after it repeatable
+func randSeed(args []interface{}) interface{} {
+	randSource.Seed(int64(ensureInt(args[0])))
+	return nil
+}
+
+// Picks from [0, n) or from [low, high)
+func randBounds(args []interface{}) (int, int) {
+	low, high := 0, ensureInt(args[0])
+	if len(args) == 2 {
+		low, high = high, ensureInt(args[1])
+	}
+	sanity.Ensure(low < high, "Empty random range [%d, %d)", low, high)
+	return low, high
+}
+
+func randInt(args []interface{}) interface{} {
+	low, high := randBounds(args)
+	return low + randSource.Intn(high - low)
+}
+
+func randFloat(args []interface{}) interface{} {
+	return randSource.Float64()
+}
+
+func randChoice(args []interface{}) interface{} {
+	list := ensureIterable(args[0])
+	sanity.Ensure(len(list) > 0, "Can't choose from an empty list")
+	return list[randSource.Intn(len(list))]
+}
+
+func randShuffle(args []interface{}) interface{} {
+	shuffled := append(List{}, ensureIterable(args[0])...)
+	randSource.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// Ignores any seed and draws from the operating system's secure source
+func randSecureInt(args []interface{}) interface{} {
+	low, high := randBounds(args)
+	n, err := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(high - low)))
+	if err != nil {
+		return raise(newError(err))
+	}
+	return low + int(n.Int64())
+}
@@ -0,0 +1,51 @@
+package runtime
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoundingFailsOutsideInts(t *testing.T) {
+	floor := roundingFunc(math.Floor)
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e19, -1e19} {
+		if _, caught := catch(func() interface{} {
+			return floor([]interface{}{value})
+		}); caught == nil || caught.Kind != "runtime" {
+			t.Errorf("Expected rounding [%v] to fail, but got [%v]", value, caught)
+		}
+	}
+	if rounded := floor([]interface{}{-2.5}); rounded != -3 {
+		t.Errorf("Expected [-3], but got [%v]", rounded)
+	}
+}
+
+func TestIntPow(t *testing.T) {
+	for _, c := range []struct{
+		base     int
+		exponent int
+		expected int
+	}{
+		{2, 10, 1024},
+		{3, 0, 1},
+		{0, 0, 1},
+		{-2, 63, math.MinInt64},
+		{-3, 3, -27},
+		{1, 3000000000, 1},
+		{-1, 3000000001, -1},
+		{3, 39, 4052555153018976267},
+	} {
+		if result := intPow(c.base, c.exponent); result != c.expected {
+			t.Errorf("Expected [%d ** %d] to be [%d], but got [%d]", c.base, c.exponent, c.expected, result)
+		}
+	}
+}
+
+func TestIntPowOverflow(t *testing.T) {
+	for _, c := range [][2]int{{2, 63}, {2, 64}, {3, 40}, {-2, 64}, {10, 3000000000}} {
+		if _, caught := catch(func() interface{} {
+			return intPow(c[0], c[1])
+		}); caught == nil {
+			t.Errorf("Expected [%d ** %d] to overflow", c[0], c[1])
+		}
+	}
+}
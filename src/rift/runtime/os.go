@@ -0,0 +1,105 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"rift/runtime/sys"
+)
+
+func osArgs(args []interface{}) interface{} {
+	scriptArgs := List{}
+	for _, arg := range sys.Args {
+		scriptArgs = append(scriptArgs, arg)
+	}
+	return scriptArgs
+}
+
+// Gives nil for unset variables
+func osEnv(args []interface{}) interface{} {
+	if value, isSet := os.LookupEnv(ensureString(args[0])); isSet {
+		return value
+	}
+	return nil
+}
+
+func osSetEnv(args []interface{}) interface{} {
+	if err := os.Setenv(ensureString(args[0]), ensureString(args[1])); err != nil {
+		return raise(newError(err))
+	}
+	return nil
+}
+
+func osCwd(args []interface{}) interface{} {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return raise(newError(err))
+	}
+	return cwd
+}
+
+func osHostname(args []interface{}) interface{} {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return raise(newError(err))
+	}
+	return hostname
+}
+
+// Runs a command to completion and gives back a map of its exit code, stdout
+// and stderr. The options map may set "dir", "env" (a map added to the current
+// environment), "stdin" and "timeout".
+func osExec(args []interface{}) interface{} {
+	if !sys.AllowExec {
+		return raise(&Error{Kind: "permission", Message: "This script isn't allowed to run processes without --allow-exec"})
+	}
+
+	var cmdArgs []string
+	if len(args) >= 2 {
+		for _, arg := range ensureList(args[1]) {
+			cmdArgs = append(cmdArgs, toString(arg))
+		}
+	}
+	opts := NewMap()
+	if len(args) == 3 {
+		opts = ensureMap(args[2])
+	}
+
+	ctx := context.Background()
+	if timeout, hasTimeout := opts.Get("timeout"); hasTimeout {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ensureDuration(timeout))
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, ensureString(args[0]), cmdArgs...)
+	if dir, hasDir := opts.Get("dir"); hasDir {
+		cmd.Dir = ensureString(dir)
+	}
+	if env, hasEnv := opts.Get("env"); hasEnv {
+		cmd.Env = os.Environ()
+		for _, entry := range ensureMap(env).Entries() {
+			pair := entry.(List)
+			cmd.Env = append(cmd.Env, toString(pair[0]) + "=" + toString(pair[1]))
+		}
+	}
+	if stdin, hasStdin := opts.Get("stdin"); hasStdin {
+		cmd.Stdin = bytes.NewBufferString(ensureString(stdin))
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if _, exited := err.(*exec.ExitError); err != nil && !exited {
+		return raise(newError(err))
+	}
+	if ctx.Err() != nil {
+		return raise(&Error{Kind: "timeout", Message: "Command timed out: " + cmd.String()})
+	}
+	return NewMap().
+		Put("code", cmd.ProcessState.ExitCode()).
+		Put("stdout", stdout.String()).
+		Put("stderr", stderr.String())
+}
@@ -1,26 +1,251 @@
 package runtime
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
 	"fmt"
+	"math"
 	"os"
 	"strings"
-	"syscall"
+	"time"
+	"unicode/utf8"
+	"rift/runtime/sys"
 	"rift/support/collections"
 	"rift/support/logging"
+	"rift/support/sanity"
 )
 
 var Predefs collections.PersistentMap
 
+var stdin, stdout, stderr *File
+
+// How many arguments a predef takes, from min up to max, or any number from
+// min when variadic
+type signature struct{
+	min      int
+	max      int
+	variadic bool
+}
+
+func exactly(n int) signature {
+	return signature{n, n, false}
+}
+
+func between(min int, max int) signature {
+	return signature{min, max, false}
+}
+
+func atLeast(min int) signature {
+	return signature{min, min, true}
+}
+
+func (s signature) ensure(actualLength int) {
+	switch {
+	case s.variadic:
+		ensureArityAtLeast(s.min, actualLength)
+	case s.min == s.max:
+		ensureArity(s.min, actualLength)
+	default:
+		ensureArityBetween(s.min, s.max, actualLength)
+	}
+}
+
+// Predefs only take positional arguments, and are only called with as many as
+// their signature allows
+func setPredef(name string, sig signature, f func([]interface{}) interface{}) {
+	Predefs.Set(name, func(args []interface{}) interface{} {
+		_, named := splitNamedArgs(args)
+		sanity.Ensure(named == nil, "Function [%s] doesn't take named arguments", name)
+		sig.ensure(len(args))
+		return f(args)
+	})
+}
+
+func setConstant(name string, value interface{}) {
+	Predefs.Set(name, value)
+}
+
 func InitPredefs() {
+	stdin = newInputStream("stdin", sys.Stdin)
+	stdout = newOutputStream("stdout", sys.Stdout)
+	stderr = newOutputStream("stderr", sys.Stderr)
+
 	Predefs = collections.NewPersistentMap()
-	Predefs.Set("std:len", length)
-	Predefs.Set("std:sprintf", sprintf)
-	Predefs.Set("std:printf", printf)
-	Predefs.Set("std:println", println)
-	Predefs.Set("std:exit", exit)
-	Predefs.Set("std:open", fileOpen)
-	Predefs.Set("std:write", fileWrite)
-	Predefs.Set("std:close", fileClose)
+	setPredef("std:len", exactly(1), length)
+	setPredef("std:sprintf", atLeast(1), sprintf)
+	setPredef("std:printf", atLeast(1), printf)
+	setPredef("std:println", atLeast(0), println)
+	setPredef("std:eprintln", atLeast(0), eprintln)
+	setPredef("std:exit", exactly(1), exit)
+	setPredef("std:is_error", exactly(1), isError)
+	setPredef("std:error", between(2, 3), errorNew)
+	setPredef("std:raise", exactly(1), errorRaise)
+	setPredef("std:ok", exactly(1), resultOk)
+	setPredef("std:err", exactly(1), resultErr)
+	setPredef("std:is_ok", exactly(1), resultIsOk)
+	setPredef("std:is_err", exactly(1), resultIsErr)
+	setPredef("std:unwrap", exactly(1), resultUnwrap)
+	setPredef("std:unwrap_or", exactly(2), resultUnwrapOr)
+	setPredef("std:result", atLeast(1), resultOf)
+	setPredef("error:kind", exactly(1), errorKind)
+	setPredef("error:message", exactly(1), errorMessage)
+	setPredef("error:data", exactly(1), errorData)
+	setPredef("error:stack", exactly(1), errorStack)
+	setConstant("std:stdin", stdin)
+	setConstant("std:stdout", stdout)
+	setConstant("std:stderr", stderr)
+	setPredef("std:open", exactly(2), fileOpen)
+	setPredef("std:read", exactly(2), fileRead)
+	setPredef("std:read_line", between(0, 1), fileReadLine)
+	setPredef("std:read_all", exactly(1), fileReadAll)
+	setPredef("std:lines", exactly(1), fileLines)
+	setPredef("std:write", exactly(2), fileWrite)
+	setPredef("std:seek", between(2, 3), fileSeek)
+	setPredef("std:close", exactly(1), fileClose)
+	setPredef("std:stat", exactly(1), fileStat)
+	setPredef("std:exists", exactly(1), fileExists)
+	setPredef("std:remove", exactly(1), fileRemove)
+	setPredef("std:mkdir", exactly(1), makeDir)
+	setPredef("std:list_dir", exactly(1), listDir)
+
+	setPredef("str:split", exactly(2), strSplit)
+	setPredef("str:join", exactly(2), strJoin)
+	setPredef("str:trim", between(1, 2), strTrim)
+	setPredef("str:replace", exactly(3), strReplace)
+	setPredef("str:contains", exactly(2), strContains)
+	setPredef("str:starts_with", exactly(2), strStartsWith)
+	setPredef("str:ends_with", exactly(2), strEndsWith)
+	setPredef("str:index_of", exactly(2), strIndexOf)
+	setPredef("str:upper", exactly(1), strUpper)
+	setPredef("str:lower", exactly(1), strLower)
+	setPredef("str:repeat", exactly(2), strRepeat)
+	setPredef("str:pad_left", between(2, 3), strPadLeft)
+	setPredef("str:pad_right", between(2, 3), strPadRight)
+	setPredef("str:chars", exactly(1), strChars)
+	setPredef("str:char_at", exactly(2), strCharAt)
+	setPredef("str:substring", between(2, 3), strSubstring)
+	setPredef("str:to_string", exactly(1), strToString)
+	setPredef("str:parse_int", exactly(1), strParseInt)
+	setPredef("str:parse_float", exactly(1), strParseFloat)
+
+	setPredef("list:map", exactly(2), listMap)
+	setPredef("list:filter", exactly(2), listFilter)
+	setPredef("list:reduce", exactly(3), listReduce)
+	setPredef("list:fold", exactly(3), listReduce)
+	setPredef("list:each", exactly(2), listEach)
+	setPredef("list:range", between(2, 3), listRange)
+	setPredef("list:zip", exactly(2), listZip)
+	setPredef("list:flatten", exactly(1), listFlatten)
+	setPredef("list:reverse", exactly(1), listReverse)
+	setPredef("list:sort", exactly(1), listSort)
+	setPredef("list:sort_by", exactly(2), listSortBy)
+	setPredef("list:any", exactly(2), listAny)
+	setPredef("list:all", exactly(2), listAll)
+	setPredef("list:find", exactly(2), listFind)
+	setPredef("list:take", exactly(2), listTake)
+	setPredef("list:drop", exactly(2), listDrop)
+	setPredef("list:group_by", exactly(2), listGroupBy)
+
+	setPredef("map:get", between(2, 3), mapGet)
+	setPredef("map:put", exactly(3), mapPut)
+	setPredef("map:delete", exactly(2), mapDelete)
+	setPredef("map:has", exactly(2), mapHas)
+	setPredef("map:keys", exactly(1), mapKeys)
+	setPredef("map:values", exactly(1), mapValues)
+	setPredef("map:entries", exactly(1), mapEntries)
+	setPredef("map:merge", atLeast(0), mapMerge)
+	setPredef("map:map_values", exactly(2), mapMapValues)
+	setPredef("map:filter", exactly(2), mapFilter)
+
+	setPredef("json:parse", exactly(1), jsonParse)
+	setPredef("json:stringify", between(1, 2), jsonStringify)
+
+	setConstant("math:pi", math.Pi)
+	setConstant("math:e", math.E)
+	setPredef("math:sqrt", exactly(1), floatFunc(math.Sqrt))
+	setPredef("math:sin", exactly(1), floatFunc(math.Sin))
+	setPredef("math:cos", exactly(1), floatFunc(math.Cos))
+	setPredef("math:tan", exactly(1), floatFunc(math.Tan))
+	setPredef("math:log", exactly(1), floatFunc(math.Log))
+	setPredef("math:exp", exactly(1), floatFunc(math.Exp))
+	setPredef("math:floor", exactly(1), roundingFunc(math.Floor))
+	setPredef("math:ceil", exactly(1), roundingFunc(math.Ceil))
+	setPredef("math:round", exactly(1), roundingFunc(math.Round))
+	setPredef("math:abs", exactly(1), mathAbs)
+	setPredef("math:min", atLeast(1), mathMin)
+	setPredef("math:max", atLeast(1), mathMax)
+	setPredef("math:clamp", exactly(3), mathClamp)
+	setPredef("math:div", exactly(2), mathDiv)
+	setPredef("math:idiv", exactly(2), mathIntDiv)
+	setPredef("math:is_nan", exactly(1), mathIsNaN)
+
+	setPredef("rand:seed", exactly(1), randSeed)
+	setPredef("rand:int", between(1, 2), randInt)
+	setPredef("rand:float", exactly(0), randFloat)
+	setPredef("rand:choice", exactly(1), randChoice)
+	setPredef("rand:shuffle", exactly(1), randShuffle)
+	setPredef("rand:secure_int", between(1, 2), randSecureInt)
+
+	setPredef("time:now", exactly(0), timeNow)
+	setPredef("time:monotonic", exactly(0), timeMonotonic)
+	setPredef("time:since", exactly(1), timeSince)
+	setPredef("time:sleep", exactly(1), timeSleep)
+	setPredef("time:duration", exactly(1), timeDuration)
+	setPredef("time:milliseconds", exactly(1), durationOf(time.Millisecond))
+	setPredef("time:seconds", exactly(1), durationOf(time.Second))
+	setPredef("time:minutes", exactly(1), durationOf(time.Minute))
+	setPredef("time:hours", exactly(1), durationOf(time.Hour))
+	setPredef("time:to_seconds", exactly(1), timeToSeconds)
+	setPredef("time:to_millis", exactly(1), timeToMillis)
+	setPredef("time:add", exactly(2), timeAdd)
+	setPredef("time:sub", exactly(2), timeSub)
+	setPredef("time:format", exactly(2), timeFormat)
+	setPredef("time:parse", between(2, 3), timeParse)
+	setPredef("time:unix", exactly(1), timeUnix)
+	setPredef("time:from_unix", exactly(1), timeFromUnix)
+	setPredef("time:in_zone", exactly(2), timeInZone)
+
+	setPredef("os:args", exactly(0), osArgs)
+	setPredef("os:env", exactly(1), osEnv)
+	setPredef("os:set_env", exactly(2), osSetEnv)
+	setPredef("os:cwd", exactly(0), osCwd)
+	setPredef("os:hostname", exactly(0), osHostname)
+	setPredef("os:exec", between(1, 3), osExec)
+
+	setPredef("re:compile", exactly(1), reCompile)
+	setPredef("re:match", exactly(2), reMatch)
+	setPredef("re:find_all", exactly(2), reFindAll)
+	setPredef("re:replace", exactly(3), reReplace)
+	setPredef("re:split", exactly(2), reSplit)
+
+	setPredef("http:get", between(1, 2), httpGet)
+	setPredef("http:post", between(2, 3), httpPost)
+	setPredef("http:request", exactly(1), httpRequest)
+	setPredef("http:get_json", between(1, 2), httpGetJSON)
+	setPredef("http:post_json", between(2, 3), httpPostJSON)
+	setPredef("http:serve", exactly(2), httpServe)
+	setPredef("http:router", exactly(1), httpRouter)
+	setPredef("http:use", exactly(2), httpUse)
+
+	setPredef("bytes:from_string", between(1, 2), bytesFromString)
+	setPredef("bytes:to_string", between(1, 2), bytesToString)
+	setPredef("bytes:slice", between(2, 3), bytesSlice)
+	setPredef("bytes:get", exactly(2), bytesGet)
+	setPredef("bytes:concat", atLeast(0), bytesConcat)
+	setPredef("bytes:from_list", exactly(1), bytesFromList)
+	setPredef("bytes:to_list", exactly(1), bytesToList)
+
+	setPredef("crypto:md5", exactly(1), digestFunc(md5.New))
+	setPredef("crypto:sha1", exactly(1), digestFunc(sha1.New))
+	setPredef("crypto:sha256", exactly(1), digestFunc(sha256.New))
+	setPredef("crypto:hmac", exactly(3), cryptoHMAC)
+	setPredef("crypto:equal", exactly(2), cryptoEqual)
+	setPredef("base64:encode", between(1, 2), base64Encode)
+	setPredef("base64:decode", between(1, 2), base64Decode)
+	setPredef("hex:encode", exactly(1), hexEncode)
+	setPredef("hex:decode", exactly(1), hexDecode)
+	setPredef("uuid:v4", exactly(0), uuidV4)
 
 	logging.Debug("Built-in environment:")
 	for k, _ := range Predefs.Freeze() {
@@ -29,53 +254,58 @@ func InitPredefs() {
 }
 
 func length(args []interface{}) interface{} {
-	ensureArity(1, len(args))
-	return len(args[0].(string))
+	switch v := args[0].(type) {
+	default:
+		sanity.Fail("Can't take the length of [%v]", v)
+		return nil
+	case string:
+		return utf8.RuneCountInString(v)
+	case Bytes:
+		return len(v)
+	case List:
+		return len(v)
+	case Range:
+		return v.Len()
+	case *Map:
+		return v.Len()
+	}
 }
 
+// The format is followed by any number of values
 func sprintf(args []interface{}) interface{} {
-	return fmt.Sprintf(args[0].(string), args[1:]...)
+	return fmt.Sprintf(ensureString(args[0]), args[1:]...)
 }
 
 func printf(args []interface{}) interface{} {
-	fmt.Printf(args[0].(string), args[1:]...)
+	fmt.Fprintf(sys.Stdout, ensureString(args[0]), args[1:]...)
 	return nil
 }
 
-func println(args []interface{}) interface{} {
+func joinArgs(args []interface{}) string {
 	var stringedArgs []string
 	for _, arg := range args {
-		stringedArgs = append(stringedArgs, fmt.Sprintf("%v", arg))
+		stringedArgs = append(stringedArgs, toString(arg))
 	}
-	fmt.Println(strings.Join(stringedArgs, ""))
-	return nil
+	return strings.Join(stringedArgs, "")
 }
 
-func exit(args []interface{}) interface{} {
-	ensureArity(1, len(args))
-	os.Exit(args[0].(int))
+// Takes any number of values, written one after the other
+func println(args []interface{}) interface{} {
+	fmt.Fprintln(sys.Stdout, joinArgs(args))
 	return nil
 }
 
-func fileOpen(args []interface{}) interface{} {
-	ensureArity(1, len(args))
-	filename := args[0].(string)
-	// TODO: Pass in mode as string?
-	fd, _ := syscall.Open(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
-	return fd
+func eprintln(args []interface{}) interface{} {
+	fmt.Fprintln(sys.Stderr, joinArgs(args))
+	return nil
 }
 
-func fileWrite(args []interface{}) interface{} {
-	ensureArity(2, len(args))
-	fd := args[0].(int)
-	data := args[1].(string)
-	syscall.Write(fd, []byte(data))
+func exit(args []interface{}) interface{} {
+	os.Exit(ensureInt(args[0]))
 	return nil
 }
 
-func fileClose(args []interface{}) interface{} {
-	ensureArity(1, len(args))
-	fd := args[0].(int)
-	syscall.Close(fd)
-	return nil
+func isError(args []interface{}) interface{} {
+	_, isErr := args[0].(*Error)
+	return isErr
 }
@@ -0,0 +1,116 @@
+package runtime
+
+import (
+	"strings"
+	"rift/lang"
+	"rift/support/collections"
+	"rift/support/sanity"
+)
+
+// Record types are called like functions to construct records, taking each
+// field by position or by name. They're named after what they're first
+// assigned to, for printing.
+type RecordType struct{
+	name   string
+	fields []string
+}
+
+func (t *RecordType) String() string {
+	if t.name == "" {
+		return "record(" + strings.Join(t.fields, ", ") + ")"
+	}
+	return "record " + t.name + "(" + strings.Join(t.fields, ", ") + ")"
+}
+
+func (t *RecordType) field(name string) int {
+	for i, field := range t.fields {
+		if field == name {
+			return i
+		}
+	}
+	sanity.Fail("Record [%s] has no field [%s]", t, name)
+	return -1
+}
+
+func (t *RecordType) construct(args []interface{}) interface{} {
+	positional, named := splitNamedArgs(args)
+	if named == nil {
+		ensureArity(len(t.fields), len(positional))
+		return &Record{t, append([]interface{}{}, positional...)}
+	}
+	ensureArityBetween(0, len(t.fields), len(positional))
+
+	values := make([]interface{}, len(t.fields))
+	given := make([]bool, len(t.fields))
+	for i, value := range positional {
+		values[i], given[i] = value, true
+	}
+	for _, name := range named.Keys() {
+		i := t.field(name.(string))
+		sanity.Ensure(!given[i], "Field [%s] given both by position and by name", name)
+		values[i], _ = named.Get(name)
+		given[i] = true
+	}
+	for i, field := range t.fields {
+		sanity.Ensure(given[i], "Missing field [%s]", field)
+	}
+	return &Record{t, values}
+}
+
+// Records can't be changed, only copied with some fields changed
+type Record struct{
+	of     *RecordType
+	values []interface{}
+}
+
+func (r *Record) String() string {
+	var fields []string
+	for i, field := range r.of.fields {
+		fields = append(fields, field + ": " + show(r.values[i]))
+	}
+	name := r.of.name
+	if name == "" {
+		name = "record"
+	}
+	return name + "(" + strings.Join(fields, ", ") + ")"
+}
+
+func ensureRecord(arg interface{}) *Record {
+	r, isRecord := arg.(*Record)
+	sanity.Ensure(isRecord, "Expected a record, but got [%v]", arg)
+	return r
+}
+
+func doRecord(node *lang.Node) interface{} {
+	fields := node.Record().Fields()
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		sanity.Ensure(!seen[field], "Field [%s] given more than once", field)
+		seen[field] = true
+	}
+	return &RecordType{fields: fields}
+}
+
+func nameRecordType(target *lang.Node, value interface{}) {
+	if t, isType := value.(*RecordType); isType && t.name == "" && target.Type == lang.REF {
+		t.name = target.Ref().String()
+	}
+}
+
+func doField(rift *lang.Rift, env collections.PersistentMap, f *lang.Field) interface{} {
+	r := ensureRecord(evaluate(rift, env, f.Value()))
+	return r.values[r.of.field(f.Name())]
+}
+
+func doWith(rift *lang.Rift, env collections.PersistentMap, w *lang.With) interface{} {
+	r := ensureRecord(evaluate(rift, env, w.Value()))
+	values := append([]interface{}{}, r.values...)
+	updated := make(map[string]bool)
+	for _, update := range w.Updates() {
+		n := update.Named()
+		sanity.Ensure(!updated[n.Name()], "Field [%s] given more than once", n.Name())
+		updated[n.Name()] = true
+		values[r.of.field(n.Name())] = evaluate(rift, env, n.Value())
+	}
+	return &Record{r.of, values}
+}
@@ -0,0 +1,91 @@
+package runtime
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+type Regex struct{
+	re *regexp.Regexp
+}
+
+func (r *Regex) String() string {
+	return "re(" + r.re.String() + ")"
+}
+
+// Accepts a compiled regex or a pattern to compile on the spot
+func regexArg(arg interface{}) *regexp.Regexp {
+	if r, isRegex := arg.(*Regex); isRegex {
+		return r.re
+	}
+	re, err := regexp.Compile(ensureString(arg))
+	if err != nil {
+		raise(&Error{Kind: "regex", Message: err.Error()})
+	}
+	return re
+}
+
+// Describes a match with its text, rune offsets, groups by position (nil for
+// groups that didn't take part) and named groups
+func matchMap(re *regexp.Regexp, s string, indexes []int) *Map {
+	groups := List{}
+	named := NewMap()
+	for i := 1; i < len(indexes) / 2; i++ {
+		var group interface{}
+		if indexes[2*i] >= 0 {
+			group = s[indexes[2*i]:indexes[2*i+1]]
+		}
+		groups = append(groups, group)
+		if name := re.SubexpNames()[i]; name != "" {
+			named = named.Put(name, group)
+		}
+	}
+	return NewMap().
+		Put("text", s[indexes[0]:indexes[1]]).
+		Put("start", utf8.RuneCountInString(s[:indexes[0]])).
+		Put("end", utf8.RuneCountInString(s[:indexes[1]])).
+		Put("groups", groups).
+		Put("named", named)
+}
+
+func reCompile(args []interface{}) interface{} {
+	re := regexArg(args[0])
+	return &Regex{re}
+}
+
+// Gives the first match, or nil if there isn't one
+func reMatch(args []interface{}) interface{} {
+	re := regexArg(args[0])
+	s := ensureString(args[1])
+	indexes := re.FindStringSubmatchIndex(s)
+	if indexes == nil {
+		return nil
+	}
+	return matchMap(re, s, indexes)
+}
+
+func reFindAll(args []interface{}) interface{} {
+	re := regexArg(args[0])
+	s := ensureString(args[1])
+	matches := List{}
+	for _, indexes := range re.FindAllStringSubmatchIndex(s, -1) {
+		matches = append(matches, matchMap(re, s, indexes))
+	}
+	return matches
+}
+
+// The replacement can refer to groups as $1 or ${name}, which needs writing as
+// \${name} in a string literal to avoid interpolation
+func reReplace(args []interface{}) interface{} {
+	re := regexArg(args[0])
+	return re.ReplaceAllString(ensureString(args[1]), ensureString(args[2]))
+}
+
+func reSplit(args []interface{}) interface{} {
+	re := regexArg(args[0])
+	parts := List{}
+	for _, part := range re.Split(ensureString(args[1]), -1) {
+		parts = append(parts, part)
+	}
+	return parts
+}
@@ -0,0 +1,118 @@
+package runtime
+
+import (
+	"fmt"
+	"rift/lang"
+	"rift/support/collections"
+	"rift/support/sanity"
+)
+
+// Results hold either a value, or the reason there isn't one, for code that
+// would rather check for failures than catch them
+type Result struct{
+	ok    bool
+	value interface{}
+}
+
+func (r Result) String() string {
+	if r.ok {
+		return "ok(" + show(r.value) + ")"
+	}
+	return "err(" + show(r.value) + ")"
+}
+
+// Raised by `?` to give an err back from the function it's in
+type propagated struct{
+	result Result
+}
+
+// Set in the environment of every function, and never a name, so that `?`
+// knows whether there's a function to give an err back from
+type inFunction struct{}
+
+func ensureResult(arg interface{}) Result {
+	r, isResult := arg.(Result)
+	sanity.Ensure(isResult, "Expected a result, but got [%v]", arg)
+	return r
+}
+
+// Errs whose reason is an error raise it, and others raise an error of kind
+// "unwrap" with the reason as its data
+func unwrap(r Result) interface{} {
+	if r.ok {
+		return r.value
+	}
+	if err, isErr := r.value.(*Error); isErr {
+		raised := *err
+		raised.Stack = nil
+		return raise(&raised)
+	}
+	return raise(&Error{Kind: "unwrap", Message: fmt.Sprintf("Unwrapped %s", r), Data: r.value})
+}
+
+// Outside of any function there's nothing to give an err back from, so a `?`
+// unwraps it instead, which a try around it can catch
+func doPropagate(rift *lang.Rift, env collections.PersistentMap, p *lang.Propagate) interface{} {
+	r := ensureResult(evaluate(rift, env, p.Value()))
+	if r.ok {
+		return r.value
+	}
+	if !env.Contains(inFunction{}) {
+		return unwrap(r)
+	}
+	panic(propagated{r})
+}
+
+// Functions give back the err that a `?` in them propagated
+func returnPropagated(f func() interface{}) (value interface{}) {
+	defer func() {
+		if failure := recover(); failure != nil {
+			p, isPropagated := failure.(propagated)
+			if !isPropagated {
+				panic(failure)
+			}
+			value = p.result
+		}
+	}()
+	return f()
+}
+
+func resultOk(args []interface{}) interface{} {
+	return Result{true, args[0]}
+}
+
+func resultErr(args []interface{}) interface{} {
+	return Result{false, args[0]}
+}
+
+func resultIsOk(args []interface{}) interface{} {
+	return ensureResult(args[0]).ok
+}
+
+func resultIsErr(args []interface{}) interface{} {
+	return !ensureResult(args[0]).ok
+}
+
+func resultUnwrap(args []interface{}) interface{} {
+	return unwrap(ensureResult(args[0]))
+}
+
+func resultUnwrapOr(args []interface{}) interface{} {
+	if r := ensureResult(args[0]); r.ok {
+		return r.value
+	}
+	return args[1]
+}
+
+// Calls the function with the rest of the arguments, so that any builtin can
+// give back a result, as in std:result(std:open, path, "r")
+func resultOf(args []interface{}) interface{} {
+	f := ensureFunc(args[0])
+	value, caught := catch(func() interface{} {
+		return f(args[1:])
+	})
+	if caught != nil {
+		return Result{false, caught}
+	}
+	return Result{true, value}
+}
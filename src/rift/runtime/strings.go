@@ -0,0 +1,170 @@
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+	"rift/support/sanity"
+)
+
+// All indexes and lengths here count runes, not bytes
+
+func strSplit(args []interface{}) interface{} {
+	parts := List{}
+	for _, part := range strings.Split(ensureString(args[0]), ensureString(args[1])) {
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+func strJoin(args []interface{}) interface{} {
+	var parts []string
+	for _, part := range ensureList(args[0]) {
+		parts = append(parts, toString(part))
+	}
+	return strings.Join(parts, ensureString(args[1]))
+}
+
+// Trims whitespace, or any of the given characters
+func strTrim(args []interface{}) interface{} {
+	s := ensureString(args[0])
+	if len(args) == 2 {
+		return strings.Trim(s, ensureString(args[1]))
+	}
+	return strings.TrimSpace(s)
+}
+
+func strReplace(args []interface{}) interface{} {
+	return strings.Replace(ensureString(args[0]), ensureString(args[1]), ensureString(args[2]), -1)
+}
+
+func strContains(args []interface{}) interface{} {
+	return strings.Contains(ensureString(args[0]), ensureString(args[1]))
+}
+
+func strStartsWith(args []interface{}) interface{} {
+	return strings.HasPrefix(ensureString(args[0]), ensureString(args[1]))
+}
+
+func strEndsWith(args []interface{}) interface{} {
+	return strings.HasSuffix(ensureString(args[0]), ensureString(args[1]))
+}
+
+func strIndexOf(args []interface{}) interface{} {
+	s := ensureString(args[0])
+	byteIndex := strings.Index(s, ensureString(args[1]))
+	if byteIndex < 0 {
+		return -1
+	}
+	return utf8.RuneCountInString(s[:byteIndex])
+}
+
+func strUpper(args []interface{}) interface{} {
+	return strings.ToUpper(ensureString(args[0]))
+}
+
+func strLower(args []interface{}) interface{} {
+	return strings.ToLower(ensureString(args[0]))
+}
+
+func strRepeat(args []interface{}) interface{} {
+	count := ensureInt(args[1])
+	sanity.Ensure(count >= 0, "Can't repeat a string [%d] times", count)
+	return strings.Repeat(ensureString(args[0]), count)
+}
+
+func padding(args []interface{}) (string, string) {
+	s := ensureString(args[0])
+	padChar := " "
+	if len(args) == 3 {
+		padChar = ensureString(args[2])
+		sanity.Ensure(utf8.RuneCountInString(padChar) == 1, "Padding must be a single character, but was [%s]", padChar)
+	}
+	missing := ensureInt(args[1]) - utf8.RuneCountInString(s)
+	if missing <= 0 {
+		return s, ""
+	}
+	return s, strings.Repeat(padChar, missing)
+}
+
+func strPadLeft(args []interface{}) interface{} {
+	s, pad := padding(args)
+	return pad + s
+}
+
+func strPadRight(args []interface{}) interface{} {
+	s, pad := padding(args)
+	return s + pad
+}
+
+func strChars(args []interface{}) interface{} {
+	chars := List{}
+	for _, c := range ensureString(args[0]) {
+		chars = append(chars, string(c))
+	}
+	return chars
+}
+
+func strCharAt(args []interface{}) interface{} {
+	runes := []rune(ensureString(args[0]))
+	index := ensureInt(args[1])
+	sanity.Ensure(index >= 0 && index < len(runes), "Index [%d] out of range for string of length [%d]", index, len(runes))
+	return string(runes[index])
+}
+
+// The end index is optional and exclusive
+func strSubstring(args []interface{}) interface{} {
+	runes := []rune(ensureString(args[0]))
+	start, end := ensureInt(args[1]), len(runes)
+	if len(args) == 3 {
+		end = ensureInt(args[2])
+	}
+	sanity.Ensure(start >= 0 && start <= end && end <= len(runes), "Invalid range [%d, %d) for string of length [%d]", start, end, len(runes))
+	return string(runes[start:end])
+}
+
+// Formats as Go's fmt would with "%" + spec, after converting the value to
+// suit the verb. Without a verb the value is written as std:println would.
+func formatValue(value interface{}, spec string) string {
+	if spec == "" {
+		return toString(value)
+	}
+	verb := spec[len(spec)-1]
+	switch verb {
+	default:
+		spec += "v"
+		value = toString(value)
+	case 'v', 's', 'q':
+		value = toString(value)
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+		value = ensureNumber(value)
+	case 'd', 'o', 'b', 'c':
+		value = ensureInt(value)
+	case 'x', 'X':
+		if _, isBytes := value.(Bytes); !isBytes {
+			value = ensureInt(value)
+		}
+	}
+	return fmt.Sprintf("%" + spec, value)
+}
+
+func strToString(args []interface{}) interface{} {
+	return toString(args[0])
+}
+
+func strParseInt(args []interface{}) interface{} {
+	i, err := strconv.Atoi(strings.TrimSpace(ensureString(args[0])))
+	if err != nil {
+		return raise(&Error{Kind: "parse", Message: err.Error()})
+	}
+	return i
+}
+
+func strParseFloat(args []interface{}) interface{} {
+	f, err := strconv.ParseFloat(strings.TrimSpace(ensureString(args[0])), 64)
+	if err != nil {
+		return raise(&Error{Kind: "parse", Message: err.Error()})
+	}
+	return f
+}
@@ -1,11 +1,14 @@
 package sys
 
 import (
+	"io"
 	"os"
 )
 
+// All Rift I/O goes through these, so embedders can redirect a script's
+// streams by swapping them out before calling runtime.Run
 var (
-	Stdin = os.Stdin
-	Stdout = os.Stdout
-	Stderr = os.Stderr
-)
\ No newline at end of file
+	Stdin  io.Reader = os.Stdin
+	Stdout io.Writer = os.Stdout
+	Stderr io.Writer = os.Stderr
+)
@@ -0,0 +1,7 @@
+package sys
+
+// The arguments meant for the script, rather than for the interpreter
+var Args []string
+
+// Scripts can only run processes with os:exec when allowed to
+var AllowExec = false
@@ -0,0 +1,157 @@
+package runtime
+
+import (
+	"time"
+	_ "time/tzdata"
+	"rift/support/sanity"
+)
+
+// Times read from time:now carry a monotonic clock reading, so differences
+// between them are safe from wall clock changes
+type Time struct{
+	t time.Time
+}
+
+func (t Time) String() string {
+	return t.t.Format(time.RFC3339Nano)
+}
+
+type Duration time.Duration
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+var startTime = time.Now()
+
+var timeLayouts = map[string]string{
+	"rfc3339":  time.RFC3339,
+	"rfc1123":  time.RFC1123,
+	"kitchen":  time.Kitchen,
+	"date":     "2006-01-02",
+	"datetime": "2006-01-02 15:04:05",
+}
+
+// Takes one of the names above or a Go layout string
+func layoutArg(arg interface{}) string {
+	layout := ensureString(arg)
+	if named, isNamed := timeLayouts[layout]; isNamed {
+		return named
+	}
+	return layout
+}
+
+func ensureTime(arg interface{}) time.Time {
+	t, isTime := arg.(Time)
+	sanity.Ensure(isTime, "Expected a time, but got [%v]", arg)
+	return t.t
+}
+
+// Plain numbers are taken as seconds
+func ensureDuration(arg interface{}) time.Duration {
+	if d, isDuration := arg.(Duration); isDuration {
+		return time.Duration(d)
+	}
+	return time.Duration(ensureNumber(arg) * float64(time.Second))
+}
+
+func timeNow(args []interface{}) interface{} {
+	return Time{time.Now()}
+}
+
+// Only ever goes forwards, counting from when the runtime started
+func timeMonotonic(args []interface{}) interface{} {
+	return Duration(time.Since(startTime))
+}
+
+func timeSince(args []interface{}) interface{} {
+	return Duration(time.Since(ensureTime(args[0])))
+}
+
+func timeSleep(args []interface{}) interface{} {
+	time.Sleep(ensureDuration(args[0]))
+	return nil
+}
+
+// Parses strings like "1h30m" or "250ms"
+func timeDuration(args []interface{}) interface{} {
+	d, err := time.ParseDuration(ensureString(args[0]))
+	if err != nil {
+		return raise(&Error{Kind: "parse", Message: err.Error()})
+	}
+	return Duration(d)
+}
+
+func durationOf(unit time.Duration) func([]interface{}) interface{} {
+	return func(args []interface{}) interface{} {
+		return Duration(ensureNumber(args[0]) * float64(unit))
+	}
+}
+
+func timeToSeconds(args []interface{}) interface{} {
+	return ensureDuration(args[0]).Seconds()
+}
+
+func timeToMillis(args []interface{}) interface{} {
+	return int(ensureDuration(args[0]) / time.Millisecond)
+}
+
+// Adds a duration to a time or to another duration
+func timeAdd(args []interface{}) interface{} {
+	if d, isDuration := args[0].(Duration); isDuration {
+		return d + Duration(ensureDuration(args[1]))
+	}
+	return Time{ensureTime(args[0]).Add(ensureDuration(args[1]))}
+}
+
+// Subtracting a time from a time gives the duration between them
+func timeSub(args []interface{}) interface{} {
+	switch lhs := args[0].(type) {
+	case Duration:
+		return lhs - Duration(ensureDuration(args[1]))
+	case Time:
+		if rhs, isTime := args[1].(Time); isTime {
+			return Duration(lhs.t.Sub(rhs.t))
+		}
+		return Time{lhs.t.Add(-ensureDuration(args[1]))}
+	}
+	sanity.Fail("Expected a time or duration, but got [%v]", args[0])
+	return nil
+}
+
+func timeFormat(args []interface{}) interface{} {
+	return ensureTime(args[0]).Format(layoutArg(args[1]))
+}
+
+// Times without a zone in their layout are taken to be UTC, or in the optional zone given
+func timeParse(args []interface{}) interface{} {
+	location := time.UTC
+	if len(args) == 3 {
+		var err error
+		if location, err = time.LoadLocation(ensureString(args[2])); err != nil {
+			return raise(&Error{Kind: "time_zone", Message: err.Error()})
+		}
+	}
+	t, err := time.ParseInLocation(layoutArg(args[1]), ensureString(args[0]), location)
+	if err != nil {
+		return raise(&Error{Kind: "parse", Message: err.Error()})
+	}
+	return Time{t}
+}
+
+func timeUnix(args []interface{}) interface{} {
+	return int(ensureTime(args[0]).Unix())
+}
+
+func timeFromUnix(args []interface{}) interface{} {
+	return Time{time.Unix(int64(ensureInt(args[0])), 0).UTC()}
+}
+
+// Zones are IANA names like "Europe/Paris", looked up in Go's embedded database
+func timeInZone(args []interface{}) interface{} {
+	location, err := time.LoadLocation(ensureString(args[1]))
+	if err != nil {
+		return raise(&Error{Kind: "time_zone", Message: err.Error()})
+	}
+	return Time{ensureTime(args[0]).In(location)}
+}
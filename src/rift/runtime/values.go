@@ -0,0 +1,376 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"rift/support/sanity"
+)
+
+// Lists are immutable; operations on them always build a new list
+type List []interface{}
+
+func (l List) String() string {
+	var values []string
+	for _, value := range l {
+		values = append(values, show(value))
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// Byte strings hold raw binary data, unlike strings which are always text
+type Bytes []byte
+
+// Prints as a literal that would read back in as the same bytes
+func (b Bytes) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("b\"")
+	for _, c := range b {
+		switch {
+		default:
+			fmt.Fprintf(&buffer, "\\x%02x", c)
+		case c == '"' || c == '\\':
+			buffer.WriteByte('\\')
+			buffer.WriteByte(c)
+		case c >= ' ' && c <= '~':
+			buffer.WriteByte(c)
+		}
+	}
+	buffer.WriteString("\"")
+	return buffer.String()
+}
+
+// Maps are immutable and remember the order in which their keys were added
+type Map struct{
+	keys   []interface{}
+	values map[interface{}]interface{}
+}
+
+func NewMap() *Map {
+	return &Map{values: make(map[interface{}]interface{})}
+}
+
+func (m *Map) Len() int {
+	return len(m.keys)
+}
+
+func (m *Map) Keys() []interface{} {
+	return m.keys
+}
+
+// Collections are equal when their contents are, which Go can't hash, so
+// they can't be keys. Nothing that can't be a key is ever in a map.
+func isKey(key interface{}) bool {
+	switch k := key.(type) {
+	case List, Bytes, *Map, *Record:
+		return false
+	case Result:
+		return isKey(k.value)
+	}
+	return key == nil || reflect.TypeOf(key).Comparable()
+}
+
+// Whole decimals are kept as ints, since they're equal, as in {1: "a"} and
+// {1.0: "a"}
+func mapKey(key interface{}) interface{} {
+	switch k := key.(type) {
+	case float64:
+		if k == math.Trunc(k) && k >= math.MinInt64 && k < math.MaxInt64 {
+			return int(k)
+		}
+	case Result:
+		return Result{k.ok, mapKey(k.value)}
+	}
+	return key
+}
+
+func (m *Map) Has(key interface{}) bool {
+	_, exists := m.Get(key)
+	return exists
+}
+
+func (m *Map) Get(key interface{}) (interface{}, bool) {
+	if !isKey(key) {
+		return nil, false
+	}
+	value, exists := m.values[mapKey(key)]
+	return value, exists
+}
+
+// Copies the whole map, so maps built up a key at a time should use a
+// mapBuilder instead
+func (m *Map) Put(key interface{}, value interface{}) *Map {
+	put := &Map{make([]interface{}, len(m.keys)), make(map[interface{}]interface{})}
+	copy(put.keys, m.keys)
+	for k, v := range m.values {
+		put.values[k] = v
+	}
+	put.set(key, value)
+	return put
+}
+
+func (m *Map) set(key interface{}, value interface{}) {
+	sanity.Ensure(isKey(key), "Can't use [%s] as a map key", show(key))
+	key = mapKey(key)
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+func (m *Map) Delete(key interface{}) *Map {
+	deleted := NewMap()
+	key = mapKey(key)
+	for _, k := range m.keys {
+		if k != key {
+			deleted.keys = append(deleted.keys, k)
+			deleted.values[k] = m.values[k]
+		}
+	}
+	return deleted
+}
+
+// Puts keys in place, as nothing else can see the map until it's built
+type mapBuilder struct{
+	built *Map
+}
+
+func newMapBuilder() *mapBuilder {
+	return &mapBuilder{NewMap()}
+}
+
+func (b *mapBuilder) Put(key interface{}, value interface{}) {
+	b.built.set(key, value)
+}
+
+func (b *mapBuilder) Get(key interface{}) (interface{}, bool) {
+	return b.built.Get(key)
+}
+
+// The builder can't be used once the map has been given out
+func (b *mapBuilder) Map() *Map {
+	built := b.built
+	b.built = nil
+	return built
+}
+
+// Each entry is a two element list of key and value
+func (m *Map) Entries() List {
+	entries := List{}
+	for _, key := range m.keys {
+		entries = append(entries, List{key, m.values[key]})
+	}
+	return entries
+}
+
+func (m *Map) String() string {
+	var entries []string
+	for _, key := range m.keys {
+		entries = append(entries, show(key) + ": " + show(m.values[key]))
+	}
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+// Ranges count up from the start to just before the end, without ever being
+// built as a list unless they're used as one
+type Range struct{
+	start int
+	end   int
+}
+
+func (r Range) Len() int {
+	if r.end < r.start {
+		return 0
+	}
+	return r.end - r.start
+}
+
+func (r Range) List() List {
+	list := make(List, 0, r.Len())
+	for i := r.start; i < r.end; i++ {
+		list = append(list, i)
+	}
+	return list
+}
+
+func (r Range) String() string {
+	return fmt.Sprintf("%d..%d", r.start, r.end)
+}
+
+// Errors can carry any data with them, and gain a stack of the functions they
+// were raised through
+type Error struct{
+	Kind    string
+	Message string
+	Data    interface{}
+	Stack   []string
+}
+
+func newError(err error) *Error {
+	switch {
+	default:
+		return &Error{Kind: "io", Message: err.Error()}
+	case os.IsNotExist(err):
+		return &Error{Kind: "not_found", Message: err.Error()}
+	case os.IsExist(err):
+		return &Error{Kind: "exists", Message: err.Error()}
+	case os.IsPermission(err):
+		return &Error{Kind: "permission", Message: err.Error()}
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) String() string {
+	return fmt.Sprintf("error(%s: %s)", e.Kind, e.Message)
+}
+
+// Numbers are equal across ints and decimals, times when they are the same
+// instant, and collections when their contents are. Functions never are.
+func valuesEqual(a interface{}, b interface{}) bool {
+	switch lhs := a.(type) {
+	default:
+		return a == b
+	case int, float64:
+		switch b.(type) {
+		default:
+			return false
+		case int, float64:
+			return compareValues(a, b) == 0
+		}
+	case Bytes:
+		rhs, isBytes := b.(Bytes)
+		return isBytes && bytes.Equal(lhs, rhs)
+	case Time:
+		rhs, isTime := b.(Time)
+		return isTime && lhs.t.Equal(rhs.t)
+	case *Record:
+		rhs, isRecord := b.(*Record)
+		if !isRecord || lhs.of != rhs.of {
+			return false
+		}
+		for i := range lhs.values {
+			if !valuesEqual(lhs.values[i], rhs.values[i]) {
+				return false
+			}
+		}
+		return true
+	case Result:
+		rhs, isResult := b.(Result)
+		return isResult && lhs.ok == rhs.ok && valuesEqual(lhs.value, rhs.value)
+	case List:
+		rhs, isList := b.(List)
+		if !isList || len(lhs) != len(rhs) {
+			return false
+		}
+		for i := range lhs {
+			if !valuesEqual(lhs[i], rhs[i]) {
+				return false
+			}
+		}
+		return true
+	case *Map:
+		rhs, isMap := b.(*Map)
+		if !isMap || lhs.Len() != rhs.Len() {
+			return false
+		}
+		for _, key := range lhs.Keys() {
+			rhsValue, exists := rhs.Get(key)
+			if !exists || !valuesEqual(lhs.values[key], rhsValue) {
+				return false
+			}
+		}
+		return true
+	case func([]interface{}) interface{}:
+		// Go can't compare functions, and the code pointer reflect gives back is
+		// shared by every closure made from the same literal, so functions are
+		// never equal, even to themselves
+		return false
+	}
+}
+
+// Numbers order among themselves, as do strings, bytes, times and durations
+func compareValues(a interface{}, b interface{}) int {
+	switch lhs := a.(type) {
+	case int:
+		switch rhs := b.(type) {
+		case int:
+			return compareInts(lhs, rhs)
+		case float64:
+			return compareFloats(float64(lhs), rhs)
+		}
+	case float64:
+		switch rhs := b.(type) {
+		case int:
+			return compareFloats(lhs, float64(rhs))
+		case float64:
+			return compareFloats(lhs, rhs)
+		}
+	case string:
+		if rhs, isString := b.(string); isString {
+			return strings.Compare(lhs, rhs)
+		}
+	case Bytes:
+		if rhs, isBytes := b.(Bytes); isBytes {
+			return bytes.Compare(lhs, rhs)
+		}
+	case Duration:
+		if rhs, isDuration := b.(Duration); isDuration {
+			return compareInts(int(lhs), int(rhs))
+		}
+	case Time:
+		if rhs, isTime := b.(Time); isTime {
+			return lhs.t.Compare(rhs.t)
+		}
+	}
+	sanity.Fail("Can't compare [%v] with [%v]", a, b)
+	return 0
+}
+
+func compareInts(lhs int, rhs int) int {
+	switch {
+	default:
+		return 0
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	}
+}
+
+func compareFloats(lhs float64, rhs float64) int {
+	switch {
+	default:
+		return 0
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	}
+}
+
+// Top-level strings print as-is, but are quoted when nested in collections
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	default:
+		return fmt.Sprintf("%v", v)
+	case nil:
+		return "nil"
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	}
+}
+
+func show(value interface{}) string {
+	if s, isString := value.(string); isString {
+		return fmt.Sprintf("%q", s)
+	}
+	return toString(value)
+}
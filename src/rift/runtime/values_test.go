@@ -0,0 +1,24 @@
+package runtime
+
+import (
+	"testing"
+)
+
+// Keys that are == find the same entry
+func TestEqualKeys(t *testing.T) {
+	m := NewMap().Put(1, "a").Put(2.5, "b").Put(Result{true, 3}, "c")
+	for _, key := range []interface{}{1, 1.0, 2.5, Result{true, 3.0}} {
+		if !m.Has(key) {
+			t.Errorf("Expected [%s] to be in [%s]", show(key), m)
+		}
+	}
+	if m.Has(1.5) || m.Has("1") {
+		t.Errorf("Expected only equal keys to be in [%s]", m)
+	}
+	if replaced := m.Put(1.0, "z"); replaced.Len() != 3 {
+		t.Errorf("Expected [1.0] to replace [1], but got [%s]", replaced)
+	}
+	if !valuesEqual(NewMap().Put(1, "a"), NewMap().Put(1.0, "a")) {
+		t.Errorf("Expected maps with equal keys to be equal")
+	}
+}
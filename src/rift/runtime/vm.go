@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"bytes"
 	"rift/lang"
 	"rift/support/collections"
 	"rift/support/logging"
@@ -32,15 +33,24 @@ func dereference(rift *lang.Rift, env collections.PersistentMap, ref *lang.Ref)
 	return env.GetOrNil(ref.String())
 }
 
+// Names assigned outside of main are kept to their rift
+func assignedName(rift *lang.Rift) func(string) string {
+	return func(name string) string {
+		if rift.Name() == "main" {
+			return name
+		}
+		return rift.Name() + ":" + name
+	}
+}
+
 func doAssignment(rift *lang.Rift, env collections.PersistentMap, assignment *lang.Assignment) interface{} {
 	// TODO: Should I use lazy assignment here?
-	var name string
-	if rift.Name() == "main"{
-		name = assignment.Ref().String()
-	} else {
-		name = rift.Name() + ":" + assignment.Ref().String()
+	value := evaluate(rift, env, assignment.Value())
+	if _, isSignal := value.(loopSignal); isSignal {
+		return value
 	}
-	env.Set(name, evaluate(rift, env, assignment.Value()))
+	nameRecordType(assignment.Target(), value)
+	destructure(rift, env, assignment.Target(), value, assignedName(rift))
 	return nil
 }
 
@@ -52,21 +62,137 @@ func doOperation(rift *lang.Rift, env collections.PersistentMap, op *lang.Operat
 	return doMath(lhsValue, rhsValue, op.Operator())
 }
 
+// Without an else, nothing is evaluated and the value is nil when no condition holds
 func doIf(rift *lang.Rift, env collections.PersistentMap, i *lang.If) interface{} {
-	cond := evaluate(rift, env, i.Condition()).(bool)
-	var lastValue interface{}
-	if cond {
-		for _, line := range i.Lines() {
-			lastValue = evaluate(rift, env, line)
+	lines := i.ElseLines()
+	for _, branch := range i.Branches() {
+		if ensureBool(evaluate(rift, env, branch.Condition)) {
+			lines = branch.Lines
+			break
 		}
-	} else {
-		for _, line := range i.ElseLines() {
-			lastValue = evaluate(rift, env, line)
+	}
+	return evalLines(rift, env, lines)
+}
+
+// Break and continue are given back as values, which blocks stop at and hand
+// up until a loop takes them
+type loopSignal string
+
+const (
+	breakSignal    loopSignal = "break"
+	continueSignal loopSignal = "continue"
+)
+
+func evalLines(rift *lang.Rift, env collections.PersistentMap, lines []*lang.Node) interface{} {
+	var lastValue interface{}
+	for _, line := range lines {
+		lastValue = evaluate(rift, env, line)
+		if _, isSignal := lastValue.(loopSignal); isSignal {
+			break
 		}
 	}
 	return lastValue
 }
 
+// For function bodies and rifts, which loop signals can't get out of
+func outsideLoop(value interface{}) interface{} {
+	signal, isSignal := value.(loopSignal)
+	sanity.Ensure(!isSignal, "Can't [%s] outside of a loop", signal)
+	return value
+}
+
+func isBreak(value interface{}) bool {
+	signal, isSignal := value.(loopSignal)
+	return isSignal && signal == breakSignal
+}
+
+// The caught error is bound by its bare name, as parameters are, even outside of
+// main
+func doTry(rift *lang.Rift, env collections.PersistentMap, t *lang.Try) interface{} {
+	if finally := t.FinallyLines(); finally != nil {
+		defer evalLines(rift, env, finally)
+	}
+	if !t.HasCatch() {
+		return evalLines(rift, env, t.Lines())
+	}
+	value, caught := catch(func() interface{} {
+		return evalLines(rift, env, t.Lines())
+	})
+	if caught != nil {
+		destructure(rift, env, t.CatchTarget(), caught, unqualified)
+		return evalLines(rift, env, t.CatchLines())
+	}
+	return value
+}
+
+// Ranges are counted through rather than built as lists. Like parameters, the
+// loop variable is bound by its bare name, even outside of main.
+func doFor(rift *lang.Rift, env collections.PersistentMap, f *lang.For) interface{} {
+	iterable := evaluate(rift, env, f.Iterable())
+	next := func(value interface{}) bool {
+		destructure(rift, env, f.Target(), value, unqualified)
+		return !isBreak(evalLines(rift, env, f.Lines()))
+	}
+	if r, isRange := iterable.(Range); isRange {
+		for i := r.start; i < r.end && next(i); i++ {
+		}
+		return nil
+	}
+	for _, value := range ensureIterable(iterable) {
+		if !next(value) {
+			break
+		}
+	}
+	return nil
+}
+
+func doWhile(rift *lang.Rift, env collections.PersistentMap, w *lang.While) interface{} {
+	for ensureBool(evaluate(rift, env, w.Condition())) {
+		if isBreak(evalLines(rift, env, w.Lines())) {
+			break
+		}
+	}
+	return nil
+}
+
+func doList(rift *lang.Rift, env collections.PersistentMap, l *lang.List) interface{} {
+	list := List{}
+	for _, value := range l.Values() {
+		list = append(list, evaluate(rift, env, value))
+	}
+	return list
+}
+
+// Tuples are lists once evaluated
+func doTuple(rift *lang.Rift, env collections.PersistentMap, t *lang.Tuple) interface{} {
+	tuple := List{}
+	for _, value := range t.Values() {
+		tuple = append(tuple, evaluate(rift, env, value))
+	}
+	return tuple
+}
+
+func doMap(rift *lang.Rift, env collections.PersistentMap, m *lang.Map) interface{} {
+	built := newMapBuilder()
+	for _, entry := range m.Entries() {
+		built.Put(evaluate(rift, env, entry.Key), evaluate(rift, env, entry.Value))
+	}
+	return built.Map()
+}
+
+func doInterpolation(rift *lang.Rift, env collections.PersistentMap, i *lang.Interpolation) interface{} {
+	var buffer bytes.Buffer
+	for _, part := range i.Parts() {
+		if part.Type == lang.FORMAT {
+			format := part.Format()
+			buffer.WriteString(formatValue(evaluate(rift, env, format.Value()), format.Spec()))
+		} else {
+			buffer.WriteString(part.Str())
+		}
+	}
+	return buffer.String()
+}
+
 func evaluate(rift *lang.Rift, env collections.PersistentMap, v interface{}) interface{} {
 	if a, isNode := v.(*lang.Node); isNode {
 		switch a.Type {
@@ -84,9 +210,42 @@ func evaluate(rift *lang.Rift, env collections.PersistentMap, v interface{}) int
 			return dereference(rift, env, a.Ref())
 		case lang.FUNC:
 			return makeFunc(rift, env, a.Func())
-		case lang.STRING:
+		case lang.LIST:
+			return doList(rift, env, a.List())
+		case lang.TUPLE:
+			return doTuple(rift, env, a.Tuple())
+		case lang.MAP:
+			return doMap(rift, env, a.Map())
+		case lang.MATCH:
+			return doMatch(rift, env, a)
+		case lang.FOR:
+			return doFor(rift, env, a.For())
+		case lang.WHILE:
+			return doWhile(rift, env, a.While())
+		case lang.PROPAGATE:
+			return doPropagate(rift, env, a.Propagate())
+		case lang.RECORD:
+			return doRecord(a)
+		case lang.FIELD:
+			return doField(rift, env, a.Field())
+		case lang.WITH:
+			return doWith(rift, env, a.With())
+		case lang.TRY:
+			return doTry(rift, env, a.Try())
+		case lang.BREAK:
+			return breakSignal
+		case lang.CONTINUE:
+			return continueSignal
+		case lang.STRING, lang.RAW_STRING:
 			return a.Str()
+		case lang.INTERPOLATION:
+			return doInterpolation(rift, env, a.Interpolation())
+		case lang.BYTES:
+			return Bytes(a.Bytes())
 		case lang.NUM:
+			if a.IsFloat() {
+				return a.Float()
+			}
 			return a.Int()
 		case lang.BOOL:
 			return a.Bool()
@@ -96,24 +255,34 @@ func evaluate(rift *lang.Rift, env collections.PersistentMap, v interface{}) int
 	}
 }
 
-func evalRift(rift *lang.Rift, env collections.PersistentMap) {
+// Gives back any error nothing caught, with the rift it got out of
+func evalRift(rift *lang.Rift, env collections.PersistentMap) *Error {
 	logging.Debug("Evaluating rift [%s]", rift.Name())
-	for _, line := range rift.Lines() {
-		evaluate(rift, env, line)
+	_, uncaught := catch(func() interface{} {
+		return outsideLoop(evalLines(rift, env, rift.Lines()))
+	})
+	if uncaught != nil {
+		uncaught.Stack = append(uncaught.Stack, "rift " + rift.Name())
 	}
+	return uncaught
 }
 
-func Run(rifts []*lang.Node) {
+// Stops at the first error nothing caught, and gives it back
+func Run(rifts []*lang.Node) *Error {
 	InitPredefs()
 	env := collections.ExtendPersistentMap(Predefs)
 	for _, riftNode := range rifts {
 		rift := riftNode.Rift()
 		if !rift.IsMain() {
-			evalRift(rift, env)
+			if err := evalRift(rift, env); err != nil {
+				return err
+			}
 		}
 	}
 	if main := mainRift(rifts); main != nil {
-		evalRift(main, env)
+		if err := evalRift(main, env); err != nil {
+			return err
+		}
 	} else {
 		// TODO: Serve functionality
 	}
@@ -121,4 +290,5 @@ func Run(rifts []*lang.Node) {
 	for k, v := range env.Freeze() {
 		logging.Debug(" |- %s = %+v", k, v)
 	}
+	return nil
 }
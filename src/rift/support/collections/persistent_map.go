@@ -28,6 +28,16 @@ func (m *PersistentMap) Set(key interface{}, value interface{}) {
 	m.mappings[key] = append(m.mappings[key], value)
 }
 
+// Overwrites the latest mapping, so that rebinding a name over and over
+// doesn't keep every value it had
+func (m *PersistentMap) Replace(key interface{}, value interface{}) {
+	if !m.Contains(key) {
+		m.Set(key, value)
+		return
+	}
+	m.mappings[key][len(m.mappings[key]) - 1] = value
+}
+
 func (m *PersistentMap) Get(key interface{}, defaultValue interface{}) interface{} {
 	value, mappingExists := m.mappings[key]
 	if !mappingExists {